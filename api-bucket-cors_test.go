@@ -0,0 +1,98 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/cors"
+)
+
+func TestBucketCors(t *testing.T) {
+	var stored []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["cors"]; !ok {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			if r.Header.Get("Content-Md5") == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			stored, _ = io.ReadAll(r.Body)
+		case http.MethodGet:
+			if stored == nil {
+				w.WriteHeader(http.StatusNotFound)
+				io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchCORSConfiguration</Code><Message>The CORS configuration does not exist</Message></Error>`)
+				return
+			}
+			w.Write(stored)
+		case http.MethodDelete:
+			stored = nil
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	config, err := c.GetBucketCors(ctx, "bucket")
+	if err != nil || config != nil {
+		t.Fatalf("expected no configuration, got %+v, %v", config, err)
+	}
+
+	if err = c.SetBucketCors(ctx, "bucket", cors.NewConfig(nil)); ToErrorResponse(err).Code != "InvalidArgument" {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+
+	rules := []cors.Rule{{
+		AllowedHeader: []string{"*"},
+		AllowedMethod: []string{"GET", "PUT"},
+		AllowedOrigin: []string{"https://example.com"},
+		ExposeHeader:  []string{"ETag"},
+		MaxAgeSeconds: 3000,
+	}}
+	if err = c.SetBucketCors(ctx, "bucket", cors.NewConfig(rules)); err != nil {
+		t.Fatal(err)
+	}
+	config, err = c.GetBucketCors(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config == nil || !reflect.DeepEqual(config.CORSRules, rules) {
+		t.Fatalf("expected %+v, got %+v", rules, config)
+	}
+
+	if err = c.RemoveBucketCors(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+	if config, err = c.GetBucketCors(ctx, "bucket"); err != nil || config != nil {
+		t.Fatalf("expected no configuration, got %+v, %v", config, err)
+	}
+}
@@ -34,7 +34,7 @@ func (c *Client) SetBucketEncryption(ctx context.Context, bucketName string, con
 		return err
 	}
 
-	if config == nil {
+	if config == nil || len(config.Rules) == 0 {
 		return errInvalidArgument("configuration cannot be empty")
 	}
 
@@ -98,6 +98,9 @@ func (c *Client) removeBucketLifecycle(ctx context.Context, bucketName string) e
 	if err != nil {
 		return err
 	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp, bucketName, "")
+	}
 	return nil
 }
 
@@ -37,6 +37,9 @@ func (c *Client) SetBucketNotification(ctx context.Context, bucketName string, c
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return err
 	}
+	if err := config.Validate(); err != nil {
+		return errInvalidArgument(err.Error())
+	}
 
 	// Get resources properly escaped and lined up before
 	// using them in http request.
@@ -180,13 +183,19 @@ func (c *Client) ListenBucketNotification(ctx context.Context, bucketName, prefi
 				contentSHA256Hex: emptySHA256Hex,
 			})
 			if err != nil {
+				if contextCanceled(ctx) {
+					return
+				}
+				// The server may be unreachable for a while,
+				// e.g. while restarting, report and reconnect.
 				select {
 				case notificationInfoCh <- notification.Info{
 					Err: err,
 				}:
 				case <-ctx.Done():
+					return
 				}
-				return
+				continue
 			}
 
 			// Validate http response, upon error return quickly.
@@ -213,15 +222,16 @@ func (c *Client) ListenBucketNotification(ctx context.Context, bucketName, prefi
 				var notificationInfo notification.Info
 				if err = json.Unmarshal(bio.Bytes(), &notificationInfo); err != nil {
 					// Unexpected error during json unmarshal, send
-					// the error to caller for actionable as needed.
+					// the error to caller for actionable as needed
+					// and move on to the next event.
 					select {
 					case notificationInfoCh <- notification.Info{
 						Err: err,
 					}:
 					case <-ctx.Done():
+						closeResponse(resp)
 						return
 					}
-					closeResponse(resp)
 					continue
 				}
 
@@ -0,0 +1,111 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestListenBucketNotificationReconnect(t *testing.T) {
+	defer func(maxRetry int) { MaxRetry = maxRetry }(MaxRetry)
+	MaxRetry = 1
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			// Drop the connection, as a restarting server would.
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.Write([]byte("not json\n"))
+		w.Write([]byte(`{"Records":[{"eventName":"s3:ObjectCreated:Put"}]}` + "\n"))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var errs int
+	received := false
+	for info := range c.ListenBucketNotification(ctx, "bucket", "", "", []string{"s3:ObjectCreated:*"}) {
+		if received {
+			// Drain until the listener stops.
+			continue
+		}
+		if info.Err != nil {
+			errs++
+			continue
+		}
+		if len(info.Records) != 1 || info.Records[0].EventName != "s3:ObjectCreated:Put" {
+			t.Errorf("unexpected event %+v", info)
+		}
+		received = true
+		cancel()
+	}
+	if !received {
+		t.Fatalf("expected the listener to reconnect and receive the event, got %v", ctx.Err())
+	}
+	// One error for the dropped connection and one for the invalid event.
+	if errs != 2 {
+		t.Errorf("expected 2 errors, got %d", errs)
+	}
+}
+
+func TestBucketNotificationRoundTrip(t *testing.T) {
+	// As returned by AWS, with capitalized filter rule names.
+	const config = `<NotificationConfiguration><QueueConfiguration><Id>1</Id><Queue>arn:aws:sqs:us-east-1:444455556666:queue</Queue><Event>s3:ObjectCreated:*</Event><Filter><S3Key><FilterRule><Name>Prefix</Name><Value>images/</Value></FilterRule><FilterRule><Name>Suffix</Name><Value>.jpg</Value></FilterRule></S3Key></Filter></QueueConfiguration></NotificationConfiguration>`
+	var stored int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			atomic.AddInt32(&stored, 1)
+			return
+		}
+		w.Write([]byte(config))
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := c.GetBucketNotification(context.Background(), "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.QueueConfigs) != 1 || cfg.QueueConfigs[0].Filter == nil {
+		t.Fatalf("Unexpected notification config %+v", cfg)
+	}
+	if err = c.SetBucketNotification(context.Background(), "bucket", cfg); err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&stored) != 1 {
+		t.Fatal("Expected the notification config to be sent to the server")
+	}
+}
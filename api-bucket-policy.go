@@ -18,11 +18,13 @@ package minio
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 
+	"github.com/minio/minio-go/v7/pkg/policy"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 )
 
@@ -88,7 +90,7 @@ func (c *Client) removeBucketPolicy(ctx context.Context, bucketName string) erro
 		return err
 	}
 
-	if resp.StatusCode != http.StatusNoContent {
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
 		return httpRespToErrorResponse(resp, bucketName, "")
 	}
 
@@ -145,3 +147,97 @@ func (c *Client) getBucketPolicy(ctx context.Context, bucketName string) (string
 	policy := string(bucketPolicyBuf)
 	return policy, err
 }
+
+// SetBucketCannedPolicy sets a canned access policy for anonymous users
+// on all objects under objectPrefix, an empty prefix applies the policy
+// to the whole bucket. Statements of the existing bucket policy which
+// apply to other prefixes are preserved. Setting policy.BucketPolicyNone
+// removes anonymous access for the prefix, the bucket policy is deleted
+// once no statements are left.
+func (c *Client) SetBucketCannedPolicy(ctx context.Context, bucketName, objectPrefix string, bucketPolicy policy.BucketPolicy) error {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return err
+	}
+	if err := s3utils.CheckValidObjectNamePrefix(objectPrefix); err != nil {
+		return err
+	}
+	if !bucketPolicy.IsValidBucketPolicy() {
+		return errInvalidArgument("Invalid bucket policy '" + string(bucketPolicy) + "'.")
+	}
+
+	policyInfo, err := c.getBucketAccessPolicy(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if bucketPolicy == policy.BucketPolicyNone && len(policyInfo.Statements) == 0 {
+		// Nothing to remove.
+		return nil
+	}
+
+	policyInfo.Statements = policy.SetPolicy(policyInfo.Statements, bucketPolicy, bucketName, objectPrefix)
+	if len(policyInfo.Statements) == 0 {
+		return c.removeBucketPolicy(ctx, bucketName)
+	}
+
+	policyBytes, err := json.Marshal(&policyInfo)
+	if err != nil {
+		return err
+	}
+	return c.putBucketPolicy(ctx, bucketName, string(policyBytes))
+}
+
+// GetBucketCannedPolicy returns the canned access policy anonymous users
+// have on objects under objectPrefix, policy.BucketPolicyNone is returned
+// when the bucket has no policy or the policy does not match any of the
+// canned policies.
+func (c *Client) GetBucketCannedPolicy(ctx context.Context, bucketName, objectPrefix string) (policy.BucketPolicy, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return policy.BucketPolicyNone, err
+	}
+	if err := s3utils.CheckValidObjectNamePrefix(objectPrefix); err != nil {
+		return policy.BucketPolicyNone, err
+	}
+	policyInfo, err := c.getBucketAccessPolicy(ctx, bucketName)
+	if err != nil {
+		return policy.BucketPolicyNone, err
+	}
+	return policy.GetPolicy(policyInfo.Statements, bucketName, objectPrefix), nil
+}
+
+// ListBucketCannedPolicies returns the canned access policies anonymous
+// users have on the resources under objectPrefix, keyed by resource
+// such as "bucket/public/*". An empty prefix lists the policies of
+// the whole bucket.
+func (c *Client) ListBucketCannedPolicies(ctx context.Context, bucketName, objectPrefix string) (map[string]policy.BucketPolicy, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := s3utils.CheckValidObjectNamePrefix(objectPrefix); err != nil {
+		return nil, err
+	}
+	policyInfo, err := c.getBucketAccessPolicy(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	return policy.GetPolicies(policyInfo.Statements, bucketName, objectPrefix), nil
+}
+
+// getBucketAccessPolicy - fetches the current bucket policy and decodes
+// it, an empty policy is returned if the bucket has no policy.
+func (c *Client) getBucketAccessPolicy(ctx context.Context, bucketName string) (policy.BucketAccessPolicy, error) {
+	policyInfo := policy.BucketAccessPolicy{Version: "2012-10-17"}
+	bucketPolicy, err := c.GetBucketPolicy(ctx, bucketName)
+	if err != nil {
+		return policyInfo, err
+	}
+	if bucketPolicy == "" {
+		return policyInfo, nil
+	}
+	if err = json.Unmarshal([]byte(bucketPolicy), &policyInfo); err != nil {
+		return policyInfo, err
+	}
+	return policyInfo, nil
+}
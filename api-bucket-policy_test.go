@@ -0,0 +1,121 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/policy"
+)
+
+// Tests setting and querying canned bucket policies on prefixes.
+func TestBucketCannedPolicy(t *testing.T) {
+	var (
+		mu           sync.Mutex
+		bucketPolicy string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["policy"]; !ok {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+			if bucketPolicy == "" {
+				w.WriteHeader(http.StatusNotFound)
+				io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchBucketPolicy</Code><Message>The bucket policy does not exist</Message></Error>`)
+				return
+			}
+			io.WriteString(w, bucketPolicy)
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			bucketPolicy = string(b)
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			bucketPolicy = ""
+			// Some servers reply with 200 instead of 204.
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	check := func(prefix string, want policy.BucketPolicy) {
+		t.Helper()
+		got, err := c.GetBucketCannedPolicy(ctx, "bucket", prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("prefix %q: expected policy %q, got %q", prefix, want, got)
+		}
+	}
+
+	check("public/", policy.BucketPolicyNone)
+	if err = c.SetBucketCannedPolicy(ctx, "bucket", "public/", policy.BucketPolicyReadOnly); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.SetBucketCannedPolicy(ctx, "bucket", "uploads/", policy.BucketPolicyWriteOnly); err != nil {
+		t.Fatal(err)
+	}
+	check("public/", policy.BucketPolicyReadOnly)
+	check("uploads/", policy.BucketPolicyWriteOnly)
+	check("private/", policy.BucketPolicyNone)
+
+	policies, err := c.ListBucketCannedPolicies(ctx, "bucket", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]policy.BucketPolicy{
+		"bucket/public/*":  policy.BucketPolicyReadOnly,
+		"bucket/uploads/*": policy.BucketPolicyWriteOnly,
+	}
+	if !reflect.DeepEqual(policies, expected) {
+		t.Errorf("expected policies %v, got %v", expected, policies)
+	}
+
+	if err = c.SetBucketCannedPolicy(ctx, "bucket", "public/", policy.BucketPolicyNone); err != nil {
+		t.Fatal(err)
+	}
+	check("public/", policy.BucketPolicyNone)
+	check("uploads/", policy.BucketPolicyWriteOnly)
+
+	if err = c.SetBucketCannedPolicy(ctx, "bucket", "uploads/", policy.BucketPolicyNone); err != nil {
+		t.Fatal(err)
+	}
+	if bucketPolicy != "" {
+		t.Errorf("expected bucket policy to be removed, got %s", bucketPolicy)
+	}
+
+	if err = c.SetBucketCannedPolicy(ctx, "bucket", "", policy.BucketPolicy("public")); err == nil {
+		t.Error("expected error for invalid canned policy")
+	}
+}
@@ -48,6 +48,11 @@ func (c *Client) SetBucketReplication(ctx context.Context, bucketName string, cf
 	if cfg.Empty() {
 		return c.removeBucketReplication(ctx, bucketName)
 	}
+	for _, rule := range cfg.Rules {
+		if err := rule.Validate(); err != nil {
+			return errInvalidArgument(err.Error())
+		}
+	}
 	// Save the updated replication.
 	return c.putBucketReplication(ctx, bucketName, cfg)
 }
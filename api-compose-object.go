@@ -30,6 +30,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"golang.org/x/net/http/httpguts"
 )
 
 // CopyDestOptions represents options specified by user for CopyObject/ComposeObject APIs
@@ -140,6 +141,23 @@ func (opts CopyDestOptions) validate() (err error) {
 	if opts.Progress != nil && opts.Size < 0 {
 		return errInvalidArgument("For progress bar effective size needs to be specified")
 	}
+	for k, v := range opts.UserMetadata {
+		if !httpguts.ValidHeaderFieldName(k) {
+			return errInvalidArgument(k + " unsupported user defined metadata name")
+		}
+		if !httpguts.ValidHeaderFieldValue(v) {
+			return errInvalidArgument(v + " unsupported user defined metadata value")
+		}
+	}
+	if opts.Mode != "" && !opts.Mode.IsValid() {
+		return errInvalidArgument(opts.Mode.String() + " unsupported retention mode")
+	}
+	if (opts.Mode != "") != !opts.RetainUntilDate.IsZero() {
+		return errInvalidArgument("Retention mode and retain until date must be set together.")
+	}
+	if opts.LegalHold != "" && !opts.LegalHold.IsValid() {
+		return errInvalidArgument(opts.LegalHold.String() + " unsupported legal-hold status")
+	}
 	return nil
 }
 
@@ -180,7 +198,9 @@ func (opts CopySrcOptions) Marshal(header http.Header) {
 		header.Set("x-amz-copy-source-if-unmodified-since", opts.MatchUnmodifiedSince.Format(http.TimeFormat))
 	}
 
-	if opts.Encryption != nil {
+	// Only SSE-C sources need their key on the copy request, other
+	// encryption headers would apply to the destination instead.
+	if opts.Encryption != nil && opts.Encryption.Type() == encrypt.SSEC {
 		encrypt.SSECopy(opts.Encryption).Marshal(header)
 	}
 }
@@ -400,7 +420,7 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 	var err error
 	for i, src := range srcs {
 		opts := StatObjectOptions{ServerSideEncryption: encrypt.SSE(src.Encryption), VersionID: src.VersionID}
-		srcObjectInfos[i], err = c.StatObject(context.Background(), src.Bucket, src.Object, opts)
+		srcObjectInfos[i], err = c.StatObject(ctx, src.Bucket, src.Object, opts)
 		if err != nil {
 			return UploadInfo{}, err
 		}
@@ -454,9 +474,11 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 	// Now, handle multipart-copy cases.
 
 	// 1. Ensure that the object has not been changed while
-	//    we are copying data.
-	for i, src := range srcs {
-		src.MatchETag = srcObjectInfos[i].ETag
+	//    we are copying data, work on a copy to leave the
+	//    caller's sources untouched.
+	srcs = append([]CopySrcOptions(nil), srcs...)
+	for i := range srcs {
+		srcs[i].MatchETag = srcObjectInfos[i].ETag
 	}
 
 	// 2. Initiate a new multipart upload.
@@ -490,6 +512,14 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 		return UploadInfo{}, err
 	}
 
+	// Abort the multipart upload if any of the following
+	// steps fails, to relinquish the storage of copied parts.
+	defer func() {
+		if err != nil {
+			c.abortMultipartUpload(ctx, dst.Bucket, dst.Object, uploadID)
+		}
+	}()
+
 	// 3. Perform copy part uploads
 	objParts := []CompletePart{}
 	partIndex := 1
@@ -512,7 +542,8 @@ func (c *Client) ComposeObject(ctx context.Context, dst CopyDestOptions, srcs ..
 				fmt.Sprintf("bytes=%d-%d", start, end))
 
 			// make upload-part-copy request
-			complPart, err := c.uploadPartCopy(ctx, dst.Bucket,
+			var complPart CompletePart
+			complPart, err = c.uploadPartCopy(ctx, dst.Bucket,
 				dst.Object, uploadID, partIndex, h)
 			if err != nil {
 				return UploadInfo{}, err
@@ -17,10 +17,18 @@
 package minio
 
 import (
+	"context"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/encrypt"
 )
 
 const (
@@ -183,3 +191,127 @@ func TestDestOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestSrcOptionsEncryption(t *testing.T) {
+	ssec, err := encrypt.NewSSEC(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	kms, err := encrypt.NewSSEKMS("key", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		encryption encrypt.ServerSide
+		copySSEC   bool
+	}{
+		{nil, false},
+		{ssec, true},
+		{encrypt.SSECopy(ssec), true},
+		{encrypt.NewSSE(), false},
+		{kms, false},
+	}
+	for i, testCase := range testCases {
+		h := make(http.Header)
+		src := CopySrcOptions{Bucket: "bucket", Object: "object", Encryption: testCase.encryption}
+		src.Marshal(h)
+		if got := h.Get(encrypt.SseCopyCustomerKey) != ""; got != testCase.copySSEC {
+			t.Errorf("Test %d: expected copy source SSE-C key %v, got %v", i+1, testCase.copySSEC, got)
+		}
+		if h.Get(encrypt.SseCustomerKey) != "" || h.Get(encrypt.SseGenericHeader) != "" {
+			t.Errorf("Test %d: unexpected destination encryption headers %v", i+1, h)
+		}
+	}
+}
+
+func TestDestOptionsValidate(t *testing.T) {
+	testCases := []struct {
+		opts       CopyDestOptions
+		shouldPass bool
+	}{
+		{CopyDestOptions{Bucket: "bucket", Object: "object"}, true},
+		{CopyDestOptions{Bucket: "bucket", Object: "object", ReplaceMetadata: true, UserMetadata: map[string]string{"Content-Type": "text/plain", "color": "blue"}}, true},
+		{CopyDestOptions{Bucket: "bucket", Object: "object", Mode: Governance, RetainUntilDate: time.Now().Add(time.Hour), LegalHold: LegalHoldEnabled}, true},
+		{CopyDestOptions{Bucket: "bucket", Object: "object", Mode: Governance}, false},
+		{CopyDestOptions{Bucket: "bucket", Object: "object", RetainUntilDate: time.Now().Add(time.Hour)}, false},
+		{CopyDestOptions{Bucket: "bucket", Object: "object", UserMetadata: map[string]string{"It has spaces": "v"}}, false},
+		{CopyDestOptions{Bucket: "bucket", Object: "object", UserMetadata: map[string]string{"color": "blue\r\nX-Injected: 1"}}, false},
+		{CopyDestOptions{Bucket: "bucket", Object: "object", Mode: RetentionMode("FOREVER")}, false},
+		{CopyDestOptions{Bucket: "bucket", Object: "object", LegalHold: LegalHoldStatus("MAYBE")}, false},
+		{CopyDestOptions{Bucket: "bucket", Object: ""}, false},
+	}
+
+	for i, testCase := range testCases {
+		err := testCase.opts.validate()
+		if testCase.shouldPass && err != nil {
+			t.Errorf("Test %d: expected to pass, failed with %v", i+1, err)
+		}
+		if !testCase.shouldPass && err == nil {
+			t.Errorf("Test %d: expected to fail, but passed", i+1)
+		}
+	}
+}
+
+func TestComposeObjectAbortOnFailure(t *testing.T) {
+	const srcSize = absMinPartSize
+
+	var (
+		mu          sync.Mutex
+		copyMatches []string
+		aborted     bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodHead:
+			w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+			w.Header().Set("Content-Length", strconv.Itoa(srcSize))
+			w.Header().Set("ETag", "\"etag"+strings.TrimPrefix(r.URL.Path, "/bucket/src")+"\"")
+		case r.Method == http.MethodPost && q.Has("uploads"):
+			io.WriteString(w, `<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>dst</Key><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`)
+		case r.Method == http.MethodPut && q.Get("uploadId") == "upload-1":
+			copyMatches = append(copyMatches, r.Header.Get("x-amz-copy-source-if-match"))
+			if q.Get("partNumber") == "2" {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				io.WriteString(w, `<Error><Code>PreconditionFailed</Code><Message>changed</Message></Error>`)
+				return
+			}
+			io.WriteString(w, `<CopyPartResult><ETag>"part"</ETag><LastModified>2015-10-21T07:28:00.000Z</LastModified></CopyPartResult>`)
+		case r.Method == http.MethodDelete && q.Get("uploadId") == "upload-1":
+			aborted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srcs := []CopySrcOptions{
+		{Bucket: "bucket", Object: "src1"},
+		{Bucket: "bucket", Object: "src2"},
+	}
+	_, err = clnt.ComposeObject(context.Background(), CopyDestOptions{Bucket: "bucket", Object: "dst"}, srcs...)
+	if ToErrorResponse(err).Code != "PreconditionFailed" {
+		t.Fatalf("expected PreconditionFailed error, got %v", err)
+	}
+	if !aborted {
+		t.Error("expected the multipart upload to be aborted")
+	}
+	if !reflect.DeepEqual(copyMatches, []string{"etag1", "etag2"}) {
+		t.Errorf("expected copies to be conditioned on the source ETags, got %v", copyMatches)
+	}
+	if srcs[0].MatchETag != "" || srcs[1].MatchETag != "" {
+		t.Error("expected the caller's sources to be left untouched")
+	}
+}
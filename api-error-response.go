@@ -20,6 +20,7 @@ package minio
 import (
 	"bytes"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -62,6 +63,23 @@ type ErrorResponse struct {
 	StatusCode int `xml:"-" json:"-"`
 }
 
+// Sentinel errors matching the ErrorResponse of common S3 error codes
+// with errors.Is, for example:
+//
+//	if errors.Is(err, minio.ErrNoSuchKey) {
+//	   ...
+//	}
+var (
+	ErrNoSuchBucket            = ErrorResponse{Code: "NoSuchBucket"}
+	ErrNoSuchKey               = ErrorResponse{Code: "NoSuchKey"}
+	ErrNoSuchUpload            = ErrorResponse{Code: "NoSuchUpload"}
+	ErrNoSuchVersion           = ErrorResponse{Code: "NoSuchVersion"}
+	ErrBucketAlreadyExists     = ErrorResponse{Code: "BucketAlreadyExists"}
+	ErrBucketAlreadyOwnedByYou = ErrorResponse{Code: "BucketAlreadyOwnedByYou"}
+	ErrAccessDenied            = ErrorResponse{Code: "AccessDenied"}
+	ErrPreconditionFailed      = ErrorResponse{Code: "PreconditionFailed"}
+)
+
 // ToErrorResponse - Returns parsed ErrorResponse struct from body and
 // http headers.
 //
@@ -76,12 +94,32 @@ type ErrorResponse struct {
 //	}
 //	...
 func ToErrorResponse(err error) ErrorResponse {
-	switch err := err.(type) {
-	case ErrorResponse:
-		return err
-	default:
-		return ErrorResponse{}
+	var errResp ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp
+	}
+	return ErrorResponse{}
+}
+
+// Is - reports whether the error has the code of target, which is
+// one of the sentinel errors such as ErrNoSuchKey.
+func (e ErrorResponse) Is(target error) bool {
+	t, ok := target.(ErrorResponse)
+	return ok && t.Code != "" && t.Code == e.Code
+}
+
+// IsRetryable - reports whether the request failing with err may succeed
+// when retried, because the server is busy or failed temporarily, or the
+// network or the host is down.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
 	}
+	var errResp ErrorResponse
+	if errors.As(err, &errResp) {
+		return isS3CodeRetryable(errResp.Code) || isHTTPStatusRetryable(errResp.StatusCode)
+	}
+	return IsNetworkOrHostDown(err, false)
 }
 
 // Error - Returns S3 error string.
@@ -172,6 +210,14 @@ func httpRespToErrorResponse(resp *http.Response, bucketName, objectName string)
 				BucketName: bucketName,
 				Key:        objectName,
 			}
+		case http.StatusNotModified:
+			errResp = ErrorResponse{
+				StatusCode: resp.StatusCode,
+				Code:       "NotModified",
+				Message:    s3ErrorResponseMap["NotModified"],
+				BucketName: bucketName,
+				Key:        objectName,
+			}
 		default:
 			msg := resp.Status
 			if len(errBody) > 0 {
@@ -262,6 +308,19 @@ func errUnexpectedEOF(totalRead, totalSize int64, bucketName, objectName string)
 	}
 }
 
+// errChecksumMismatch - Checksum of the data read does not match the
+// checksum stored with the object.
+func errChecksumMismatch(expected, actual Checksum, bucketName, objectName string) error {
+	msg := fmt.Sprintf("The %s checksum ‘%s’ of the data read does not match the checksum ‘%s’ of the object.", expected.Type, actual.Encoded(), expected.Encoded())
+	return ErrorResponse{
+		StatusCode: http.StatusBadRequest,
+		Code:       "XAmzContentChecksumMismatch",
+		Message:    msg,
+		BucketName: bucketName,
+		Key:        objectName,
+	}
+}
+
 // errInvalidArgument - Invalid argument response.
 func errInvalidArgument(message string) error {
 	return ErrorResponse{
@@ -19,7 +19,9 @@ package minio
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -152,6 +154,8 @@ func TestHttpRespToErrorResponse(t *testing.T) {
 		genErrResponse(setCommonHeaders(&http.Response{StatusCode: http.StatusBadRequest}), "Bad Request", "Bad Request", "minio-bucket", ""),
 		genErrResponse(setCommonHeaders(&http.Response{StatusCode: http.StatusInternalServerError}), "Internal Server Error", "my custom object store error", "minio-bucket", ""),
 		genErrResponse(setCommonHeaders(&http.Response{StatusCode: http.StatusInternalServerError}), "Internal Server Error", "my custom object store error, with way too long body", "minio-bucket", ""),
+		genErrResponse(setCommonHeaders(&http.Response{StatusCode: http.StatusNotModified}), "NotModified", "The object was not modified since the specified ETag or time.", "minio-bucket", "Asia/"),
+		genErrResponse(setCommonHeaders(&http.Response{StatusCode: http.StatusPreconditionFailed}), "PreconditionFailed", "At least one of the pre-conditions you specified did not hold", "minio-bucket", "Asia/"),
 	}
 
 	// List of http response to be used as input.
@@ -165,6 +169,8 @@ func TestHttpRespToErrorResponse(t *testing.T) {
 		genEmptyBodyResponse(http.StatusBadRequest),
 		setCommonHeaders(createErrorResponse(http.StatusInternalServerError, []byte("my custom object store error\n"))),
 		setCommonHeaders(createErrorResponse(http.StatusInternalServerError, append([]byte("my custom object store error, with way too long body\n"), bytes.Repeat([]byte("\n"), 2*1024*1024)...))),
+		genEmptyBodyResponse(http.StatusNotModified),
+		genEmptyBodyResponse(http.StatusPreconditionFailed),
 	}
 
 	testCases := []struct {
@@ -184,6 +190,8 @@ func TestHttpRespToErrorResponse(t *testing.T) {
 		{"minio-bucket", "", inputResponses[6], expectedErrResponse[6]},
 		{"minio-bucket", "", inputResponses[7], expectedErrResponse[7]},
 		{"minio-bucket", "", inputResponses[8], expectedErrResponse[8]},
+		{"minio-bucket", "Asia/", inputResponses[9], expectedErrResponse[9]},
+		{"minio-bucket", "Asia/", inputResponses[10], expectedErrResponse[10]},
 	}
 
 	for i, testCase := range testCases {
@@ -286,3 +294,43 @@ func TestErrorResponseComparable(t *testing.T) {
 		t.Fatalf("ErrorResponse should be comparable")
 	}
 }
+
+func TestErrorResponseIs(t *testing.T) {
+	err := fmt.Errorf("get object: %w", ErrorResponse{
+		StatusCode: http.StatusNotFound,
+		Code:       "NoSuchKey",
+		Message:    "The specified key does not exist.",
+		BucketName: "bucket",
+		Key:        "object",
+	})
+	if !errors.Is(err, ErrNoSuchKey) {
+		t.Error("Expected the error to be ErrNoSuchKey")
+	}
+	if errors.Is(err, ErrNoSuchBucket) || errors.Is(err, ErrorResponse{}) {
+		t.Error("Expected the error not to match other codes")
+	}
+	if ToErrorResponse(err).Key != "object" {
+		t.Errorf("Expected the wrapped error response, got %#v", ToErrorResponse(err))
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	testCases := []struct {
+		err       error
+		retryable bool
+	}{
+		{nil, false},
+		{ErrorResponse{Code: "SlowDown", StatusCode: http.StatusServiceUnavailable}, true},
+		{ErrorResponse{Code: "UnknownError", StatusCode: http.StatusBadGateway}, true},
+		{fmt.Errorf("wrapped: %w", ErrorResponse{Code: "InternalError"}), true},
+		{ErrorResponse{Code: "NoSuchKey", StatusCode: http.StatusNotFound}, false},
+		{errors.New("dial tcp: connection refused"), true},
+		{context.Canceled, false},
+		{errors.New("invalid argument"), false},
+	}
+	for i, testCase := range testCases {
+		if retryable := IsRetryable(testCase.err); retryable != testCase.retryable {
+			t.Errorf("Test %d: expected %v for %v, got %v", i+1, testCase.retryable, testCase.err, retryable)
+		}
+	}
+}
@@ -183,12 +183,7 @@ func (c *Client) GetObjectAttributes(ctx context.Context, bucketName, objectName
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		ER := new(ErrorResponse)
-		if err := xml.NewDecoder(resp.Body).Decode(ER); err != nil {
-			return nil, err
-		}
-
-		return nil, *ER
+		return nil, httpRespToErrorResponse(resp, bucketName, objectName)
 	}
 
 	OA := new(ObjectAttributes)
@@ -0,0 +1,94 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetObjectAttributes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["attributes"]; !ok {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		if r.URL.Path == "/bucket/missing" {
+			// Error responses without a body, as sent by some proxies.
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get(amzObjectAttributes) != GetObjectAttributesTags || r.Header.Get(amzMaxParts) != "2" ||
+			r.Header.Get(amzPartNumberMarker) != "1" || r.URL.Query().Get("versionId") != "v1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set(amzVersionID, "v1")
+		io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?>
+<GetObjectAttributesResponse>
+<ETag>b54357faf0632cce46e942fa68356b38-3</ETag>
+<Checksum><ChecksumCRC32C>Zm9v</ChecksumCRC32C></Checksum>
+<ObjectParts>
+<PartsCount>3</PartsCount><PartNumberMarker>1</PartNumberMarker><NextPartNumberMarker>3</NextPartNumberMarker><MaxParts>2</MaxParts><IsTruncated>false</IsTruncated>
+<Part><ChecksumCRC32C>YmFy</ChecksumCRC32C><PartNumber>2</PartNumber><Size>5242880</Size></Part>
+<Part><ChecksumCRC32C>YmF6</ChecksumCRC32C><PartNumber>3</PartNumber><Size>1024</Size></Part>
+</ObjectParts>
+<StorageClass>STANDARD</StorageClass>
+<ObjectSize>10486784</ObjectSize>
+</GetObjectAttributesResponse>`)
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrs, err := c.GetObjectAttributes(context.Background(), "bucket", "object", ObjectAttributesOptions{
+		VersionID:        "v1",
+		MaxParts:         2,
+		PartNumberMarker: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attrs.VersionID != "v1" || attrs.LastModified.Year() != 2006 {
+		t.Errorf("unexpected version or modification time: %s, %s", attrs.VersionID, attrs.LastModified)
+	}
+	if attrs.ETag != "b54357faf0632cce46e942fa68356b38-3" || attrs.StorageClass != "STANDARD" ||
+		attrs.ObjectSize != 10486784 || attrs.Checksum.ChecksumCRC32C != "Zm9v" {
+		t.Errorf("unexpected attributes: %+v", attrs.ObjectAttributesResponse)
+	}
+	parts := attrs.ObjectParts
+	if parts.PartsCount != 3 || parts.NextPartNumberMarker != 3 || parts.IsTruncated || len(parts.Parts) != 2 {
+		t.Fatalf("unexpected parts: %+v", parts)
+	}
+	if p := parts.Parts[1]; p.PartNumber != 3 || p.Size != 1024 || p.ChecksumCRC32C != "YmF6" {
+		t.Errorf("unexpected part: %+v", p)
+	}
+
+	_, err = c.GetObjectAttributes(context.Background(), "bucket", "missing", ObjectAttributesOptions{})
+	if !errors.Is(err, ErrNoSuchKey) || ToErrorResponse(err).StatusCode != http.StatusNotFound {
+		t.Fatalf("expected NoSuchKey, got %v", err)
+	}
+}
@@ -19,10 +19,14 @@ package minio
 
 import (
 	"context"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
+	"sync"
+	"sync/atomic"
 
+	"github.com/goccy/go-json"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 )
 
@@ -37,6 +41,9 @@ func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePat
 		return err
 	}
 
+	// The ranges downloaded concurrently share the bandwidth limit.
+	opts.bandwidth = c.objectBandwidth(opts.bandwidth, opts.BandwidthLimit)
+
 	// Verify if destination already exists.
 	st, err := os.Stat(filePath)
 	if err == nil {
@@ -68,11 +75,30 @@ func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePat
 		return err
 	}
 
+	// Only the whole object can be verified against its checksum.
+	verify := opts.VerifyChecksum && opts.PartNumber == 0 && opts.headers["Range"] == ""
+
 	// Write to a temporary file "fileName.part.minio" before saving.
 	filePartPath := filePath + sum256Hex([]byte(objectStat.ETag)) + ".part.minio"
 
-	// If exists, open in append mode. If not create it as a part file.
-	filePart, err := os.OpenFile(filePartPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	// Download ranges of the object concurrently if requested, not
+	// possible when the caller asked for a range or part themselves.
+	// Objects which fit in a single range use a single stream.
+	parallel := opts.NumThreads > 1 && opts.PartNumber == 0 && opts.headers["Range"] == "" &&
+		objectStat.Size > minPartSize
+	flags := os.O_CREATE | os.O_APPEND | os.O_WRONLY
+	if parallel {
+		// Ranges are written at their offsets.
+		flags = os.O_CREATE | os.O_WRONLY
+	}
+
+	// Ranges already downloaded in parallel are recorded in a state
+	// file next to the part file, to resume after a crash.
+	fileStatePath := filePartPath + ".json"
+
+	// If exists, open in append mode unless downloading in parallel.
+	// If not create it as a part file.
+	filePart, err := os.OpenFile(filePartPath, flags, 0o600)
 	if err != nil {
 		return err
 	}
@@ -80,35 +106,72 @@ func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePat
 	// If we return early with an error, be sure to close and delete
 	// filePart.  If we have an error along the way there is a chance
 	// that filePart is somehow damaged, and we should discard it.
+	// The ranges recorded by a failed parallel download are kept to
+	// resume with, unless the object changed.
 	closeAndRemove := true
+	keepState := false
 	defer func() {
 		if closeAndRemove {
 			_ = filePart.Close()
-			_ = os.Remove(filePartPath)
+			if !keepState {
+				_ = os.Remove(filePartPath)
+			}
+		}
+		if !keepState {
+			_ = os.Remove(fileStatePath)
 		}
 	}()
 
-	// Issue Stat to get the current offset.
-	st, err = filePart.Stat()
-	if err != nil {
-		return err
+	if parallel {
+		err = c.fGetObjectParallel(ctx, bucketName, objectName, filePart, fileStatePath, objectStat, opts)
+		if errors.Is(err, errRangeNotSupported) {
+			// Server ignores ranges, fall back to a single stream.
+			parallel = false
+			if err = filePart.Truncate(0); err != nil {
+				return err
+			}
+			_, err = filePart.Seek(0, io.SeekStart)
+		}
+		if err != nil {
+			keepState = ToErrorResponse(err).Code != "PreconditionFailed"
+			return err
+		}
 	}
 
-	// Initialize get object request headers to set the
-	// appropriate range offsets to read from.
-	if st.Size() > 0 {
-		opts.SetRange(st.Size(), 0)
-	}
+	if !parallel {
+		// A part file left behind by a parallel download has holes,
+		// it can not be appended to.
+		if _, err = os.Stat(fileStatePath); err == nil {
+			if err = filePart.Truncate(0); err != nil {
+				return err
+			}
+		}
 
-	// Seek to current position for incoming reader.
-	objectReader, objectStat, _, err := c.getObject(ctx, bucketName, objectName, opts)
-	if err != nil {
-		return err
-	}
+		// Issue Stat to get the current offset.
+		st, err = filePart.Stat()
+		if err != nil {
+			return err
+		}
 
-	// Write to the part file.
-	if _, err = io.CopyN(filePart, objectReader, objectStat.Size); err != nil {
-		return err
+		// Initialize get object request headers to set the
+		// appropriate range offsets to read from.
+		if st.Size() > 0 {
+			opts.SetRange(st.Size(), 0)
+			if opts.Progress != nil {
+				io.CopyN(io.Discard, opts.Progress, st.Size())
+			}
+		}
+
+		// Seek to current position for incoming reader.
+		objectReader, objectStat, _, err := c.getObject(ctx, bucketName, objectName, opts)
+		if err != nil {
+			return err
+		}
+
+		// Write to the part file.
+		if _, err = io.CopyN(filePart, newHook(objectReader, opts.Progress), objectStat.Size); err != nil {
+			return err
+		}
 	}
 
 	// Close the file before rename, this is specifically needed for Windows users.
@@ -117,6 +180,15 @@ func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePat
 		return err
 	}
 
+	// Verify all of the part file, including data downloaded by an
+	// earlier, interrupted, download.
+	if verify {
+		if err = verifyFileChecksum(filePartPath, objectStat, bucketName, objectName); err != nil {
+			_ = os.Remove(filePartPath)
+			return err
+		}
+	}
+
 	// Safely completed. Now commit by renaming to actual filename.
 	if err = os.Rename(filePartPath, filePath); err != nil {
 		return err
@@ -125,3 +197,225 @@ func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePat
 	// Return.
 	return nil
 }
+
+// verifyFileChecksum - verifies the content of the file downloaded
+// against the checksum of the object, if the object has one.
+func verifyFileChecksum(filePath string, objectStat ObjectInfo, bucketName, objectName string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	verifier := &checksumVerifier{bucketName: bucketName, objectName: objectName}
+	buf := make([]byte, 1024*1024)
+	var offset int64
+	for {
+		n, err := f.Read(buf)
+		verifier.update(objectStat, offset, buf[:n])
+		offset += int64(n)
+		if err == io.EOF {
+			return verifier.verify(objectStat.Size)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// errRangeNotSupported - returned when the server replied to a ranged
+// GET request with the whole object.
+var errRangeNotSupported = errors.New("server does not support ranged GET requests")
+
+// fGetObjectState - ranges of the object downloaded to the part file by
+// a parallel FGetObject.
+type fGetObjectState struct {
+	ETag     string `json:"etag"`
+	Size     int64  `json:"size"`
+	PartSize int64  `json:"partSize"`
+	Done     []int  `json:"done"`
+}
+
+// loadFGetObjectState - loads the ranges downloaded to the part file by
+// an earlier, interrupted, download of the same object version.
+func loadFGetObjectState(filePart *os.File, fileStatePath string, state fGetObjectState) map[int]bool {
+	done := make(map[int]bool)
+	st, err := filePart.Stat()
+	if err != nil || st.Size() != state.Size {
+		return done
+	}
+	stateBytes, err := os.ReadFile(fileStatePath)
+	if err != nil {
+		return done
+	}
+	var saved fGetObjectState
+	if err = json.Unmarshal(stateBytes, &saved); err != nil {
+		return done
+	}
+	if saved.ETag != state.ETag || saved.Size != state.Size || saved.PartSize != state.PartSize {
+		return done
+	}
+	for _, partIndex := range saved.Done {
+		done[partIndex] = true
+	}
+	return done
+}
+
+// save - records the downloaded ranges, the state is written to a
+// temporary file first so that a crash never leaves it truncated.
+func (state fGetObjectState) save(fileStatePath string) error {
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(fileStatePath+".tmp", stateBytes, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(fileStatePath+".tmp", fileStatePath)
+}
+
+// fGetObjectParallel - downloads the object into filePart with up to
+// opts.NumThreads concurrent ranged GET requests, each range is written
+// at its own offset of the pre-sized file. Ranges recorded as done in
+// the state file by an interrupted download are not downloaded again.
+func (c *Client) fGetObjectParallel(ctx context.Context, bucketName, objectName string, filePart *os.File, fileStatePath string, objectStat ObjectInfo, opts GetObjectOptions) error {
+	totalPartsCount, partSize, _, err := OptimalPartInfo(objectStat.Size, 0)
+	if err != nil {
+		return err
+	}
+
+	state := fGetObjectState{
+		ETag:     objectStat.ETag,
+		Size:     objectStat.Size,
+		PartSize: partSize,
+	}
+	done := loadFGetObjectState(filePart, fileStatePath, state)
+	if len(done) == 0 {
+		// Nothing to resume, discard any stale content.
+		if err = filePart.Truncate(0); err != nil {
+			return err
+		}
+	}
+
+	// Pre-size the file so that ranges can be written in any order.
+	if err = filePart.Truncate(objectStat.Size); err != nil {
+		return err
+	}
+
+	// Detect if snowball is server location we are talking to.
+	var snowball bool
+	if location, ok := c.bucketLocCache.Get(bucketName); ok {
+		snowball = location == "snowball"
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// rangeLength - returns the length of the range at partIndex.
+	rangeLength := func(partIndex int) int64 {
+		offset := int64(partIndex) * partSize
+		if offset+partSize > objectStat.Size {
+			return objectStat.Size - offset
+		}
+		return partSize
+	}
+
+	var (
+		wg           sync.WaitGroup
+		stateMu      sync.Mutex
+		totalWritten atomic.Int64
+		partsCh      = make(chan int)
+		errCh        = make(chan error, opts.NumThreads)
+	)
+	for partIndex := range done {
+		state.Done = append(state.Done, partIndex)
+		totalWritten.Add(rangeLength(partIndex))
+		if opts.Progress != nil {
+			io.CopyN(io.Discard, opts.Progress, rangeLength(partIndex))
+		}
+	}
+	for i := 0; i < int(opts.NumThreads); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for partIndex := range partsCh {
+				offset := int64(partIndex) * partSize
+				length := rangeLength(partIndex)
+
+				ropts := opts.clone()
+				ropts.SetRange(offset, offset+length-1)
+				// Check whether this is snowball
+				// if yes do not use If-Match feature
+				// it doesn't work.
+				if objectStat.ETag != "" && !snowball {
+					ropts.SetMatchETag(objectStat.ETag)
+				}
+				n, err := c.getObjectRangeToFile(ctx, bucketName, objectName, filePart, offset, length, ropts)
+				totalWritten.Add(n)
+				if err == nil {
+					// The range must be on disk before it is recorded as done.
+					err = filePart.Sync()
+				}
+				if err == nil {
+					stateMu.Lock()
+					state.Done = append(state.Done, partIndex)
+					err = state.save(fileStatePath)
+					stateMu.Unlock()
+				}
+				if err != nil {
+					errCh <- err
+					// Stop the remaining downloads.
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+
+	// Hand out the ranges to the workers.
+feed:
+	for partIndex := 0; partIndex < totalPartsCount; partIndex++ {
+		if done[partIndex] {
+			continue
+		}
+		select {
+		case partsCh <- partIndex:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(partsCh)
+	wg.Wait()
+	close(errCh)
+
+	// Report the error which stopped the download first.
+	if err = <-errCh; err != nil {
+		return err
+	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+	if written := totalWritten.Load(); written != objectStat.Size {
+		return errUnexpectedEOF(written, objectStat.Size, bucketName, objectName)
+	}
+	return nil
+}
+
+// getObjectRangeToFile - downloads length bytes of the object with the
+// ranged GET request described by opts and writes them to file at offset.
+func (c *Client) getObjectRangeToFile(ctx context.Context, bucketName, objectName string, file *os.File, offset, length int64, opts GetObjectOptions) (int64, error) {
+	reader, _, header, err := c.getObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	if header.Get("Content-Range") == "" {
+		return 0, errRangeNotSupported
+	}
+	n, err := io.CopyN(io.NewOffsetWriter(file, offset), newHook(reader, opts.Progress), length)
+	if err == io.EOF {
+		err = errUnexpectedEOF(n, length, bucketName, objectName)
+	}
+	return n, err
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/minio/minio-go/v7/pkg/s3utils"
@@ -38,6 +39,9 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 		return nil, err
 	}
 
+	// The requests of the object share the bandwidth limit.
+	opts.bandwidth = c.objectBandwidth(opts.bandwidth, opts.BandwidthLimit)
+
 	gctx, cancel := context.WithCancel(ctx)
 
 	// Detect if snowball is server location we are talking to.
@@ -53,6 +57,11 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 		totalRead  int
 	)
 
+	// ReadAt requests are served independently of the sequential
+	// reader below, take a snapshot of the options before they are
+	// modified by subsequent Read and Seek calls.
+	readAtOpts := opts.clone()
+
 	// Create request channel.
 	reqCh := make(chan getRequest)
 	// Create response channel.
@@ -73,22 +82,18 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 		// Used to verify if etag of object has changed since last read.
 		var etag string
 
+		// Headers of the most recent GET response.
+		var respHeader http.Header
+
 		for req := range reqCh {
 			// If this is the first request we may not need to do a getObject request yet.
 			if req.isFirstReq {
-				// First request is a Read/ReadAt.
+				// First request is a Read.
 				if req.isReadOp {
-					// Differentiate between wanting the whole object and just a range.
-					if req.isReadAt {
-						// If this is a ReadAt request only get the specified range.
-						// Range is set with respect to the offset and length of the buffer requested.
-						// Do not set objectInfo from the first readAt request because it will not get
-						// the whole object.
-						opts.SetRange(req.Offset, req.Offset+int64(len(req.Buffer))-1)
-					} else if req.Offset > 0 {
+					if req.Offset > 0 {
 						opts.SetRange(req.Offset, 0)
 					}
-					httpReader, objectInfo, _, err = c.getObject(gctx, bucketName, objectName, opts)
+					httpReader, objectInfo, respHeader, err = c.getObject(gctx, bucketName, objectName, opts)
 					if err != nil {
 						resCh <- getResponse{Error: err}
 						return
@@ -120,13 +125,14 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 					// Send back the first response.
 					resCh <- getResponse{
 						objectInfo: objectInfo,
+						header:     respHeader,
 						Size:       size,
 						Error:      err,
 						didRead:    true,
 					}
 				} else {
 					// First request is a Stat or Seek call.
-					// Only need to run a StatObject until an actual Read or ReadAt request comes through.
+					// Only need to run a StatObject until an actual Read request comes through.
 
 					// Remove range header if already set, for stat Operations to get original file size.
 					delete(opts.headers, "Range")
@@ -171,7 +177,6 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 				// request if it was a stat or seek it must be checked
 				// if the object has been read or not to only initialize
 				// new ones when they haven't been already.
-				// All readAt requests are new requests.
 				if req.DidOffsetChange || !req.beenRead {
 					// Check whether this is snowball
 					// if yes do not use If-Match feature
@@ -183,17 +188,13 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 						// Close previously opened http reader.
 						httpReader.Close()
 					}
-					// If this request is a readAt only get the specified range.
-					if req.isReadAt {
-						// Range is set with respect to the offset and length of the buffer requested.
-						opts.SetRange(req.Offset, req.Offset+int64(len(req.Buffer))-1)
-					} else if req.Offset > 0 { // Range is set with respect to the offset.
+					if req.Offset > 0 { // Range is set with respect to the offset.
 						opts.SetRange(req.Offset, 0)
 					} else {
 						// Remove range header if already set
 						delete(opts.headers, "Range")
 					}
-					httpReader, objectInfo, _, err = c.getObject(gctx, bucketName, objectName, opts)
+					httpReader, objectInfo, respHeader, err = c.getObject(gctx, bucketName, objectName, opts)
 					if err != nil {
 						resCh <- getResponse{
 							Error: err,
@@ -233,24 +234,39 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string, o
 					Error:      err,
 					didRead:    true,
 					objectInfo: objectInfo,
+					header:     respHeader,
 				}
 			}
 		}
 	}()
 
 	// Create a newObject through the information sent back by reqCh.
-	return newObject(gctx, cancel, reqCh, resCh), nil
+	obj := newObject(gctx, cancel, reqCh, resCh)
+	obj.progress = opts.Progress
+	if opts.VerifyChecksum && opts.PartNumber == 0 && opts.headers["Range"] == "" {
+		obj.verifier = &checksumVerifier{bucketName: bucketName, objectName: objectName}
+	}
+	obj.readAtFn = func(ctx context.Context, b []byte, offset int64, etag string) (int, error) {
+		ropts := readAtOpts.clone()
+		// Check whether this is snowball
+		// if yes do not use If-Match feature
+		// it doesn't work.
+		if etag != "" && !snowball {
+			ropts.SetMatchETag(etag)
+		}
+		return c.getObjectRange(ctx, bucketName, objectName, ropts, b, offset)
+	}
+	return obj, nil
 }
 
 // get request message container to communicate with internal
 // go-routine.
 type getRequest struct {
 	Buffer            []byte
-	Offset            int64 // readAt offset.
+	Offset            int64 // read offset.
 	DidOffsetChange   bool  // Tracks the offset changes for Seek requests.
 	beenRead          bool  // Determines if this is the first time an object is being read.
-	isReadAt          bool  // Determines if this request is a request to a specific range
-	isReadOp          bool  // Determines if this request is a Read or Read/At request.
+	isReadOp          bool  // Determines if this request is a Read request.
 	isFirstReq        bool  // Determines if this request is the first time an object is being accessed.
 	settingObjectInfo bool  // Determines if this request is to set the objectInfo of an object.
 }
@@ -259,8 +275,9 @@ type getRequest struct {
 type getResponse struct {
 	Size       int
 	Error      error
-	didRead    bool       // Lets subsequent calls know whether or not httpReader has been initiated.
-	objectInfo ObjectInfo // Used for the first request.
+	didRead    bool        // Lets subsequent calls know whether or not httpReader has been initiated.
+	objectInfo ObjectInfo  // Used for the first request.
+	header     http.Header // Headers of the GET response the data was read from.
 }
 
 // Object represents an open object. It implements
@@ -294,6 +311,19 @@ type Object struct {
 
 	// Keeps track of if objectInfo has been set yet.
 	objectInfoSet bool
+
+	// Headers of the most recent GET response.
+	respHeader http.Header
+
+	// Fetches a byte range of the object with a dedicated request,
+	// used by ReadAt so it never disturbs the sequential reader.
+	readAtFn func(ctx context.Context, b []byte, offset int64, etag string) (int, error)
+
+	// Reports the data returned by Read and ReadAt, may be nil.
+	progress io.Reader
+
+	// Verifies the data returned by Read, may be nil.
+	verifier *checksumVerifier
 }
 
 // doGetRequest - sends and blocks on the firstReqCh and reqCh of an object.
@@ -319,12 +349,15 @@ func (o *Object) doGetRequest(request getRequest) (getResponse, error) {
 		// The object has been operated on.
 		o.isStarted = true
 	}
-	// Set the objectInfo if the request was not readAt
-	// and it hasn't been set before.
-	if !o.objectInfoSet && !request.isReadAt {
+	// Set the objectInfo if it hasn't been set before.
+	if !o.objectInfoSet {
 		o.objectInfo = response.objectInfo
 		o.objectInfoSet = true
 	}
+	// Keep the headers of the latest GET response.
+	if response.header != nil {
+		o.respHeader = response.header
+	}
 	// Set beenRead only if it has not been set before.
 	if !o.beenRead {
 		o.beenRead = response.didRead
@@ -336,7 +369,7 @@ func (o *Object) doGetRequest(request getRequest) (getResponse, error) {
 }
 
 // setOffset - handles the setting of offsets for
-// Read/ReadAt/Seek requests.
+// Read/Seek requests.
 func (o *Object) setOffset(bytesRead int64) error {
 	// Update the currentOffset.
 	o.currOffset += bytesRead
@@ -390,9 +423,18 @@ func (o *Object) Read(b []byte) (n int, err error) {
 
 	// Bytes read.
 	bytesRead := int64(response.Size)
+	o.reportProgress(b[:response.Size])
+	o.verifier.update(o.objectInfo, readReq.Offset, b[:response.Size])
 
 	// Set the new offset.
 	oerr := o.setOffset(bytesRead)
+	if oerr == io.EOF || err == io.EOF {
+		// All of the object was read.
+		if verr := o.verifier.verify(o.objectInfo.Size); verr != nil {
+			o.prevErr = verr
+			return response.Size, verr
+		}
+	}
 	if oerr != nil {
 		// Save the error for future calls.
 		o.prevErr = oerr
@@ -432,76 +474,106 @@ func (o *Object) Stat() (ObjectInfo, error) {
 	return o.objectInfo, nil
 }
 
+// ObjectResponse describes the most recent GET response an Object has
+// read data from.
+type ObjectResponse struct {
+	// Header holds the response headers, such as Last-Modified, ETag,
+	// Content-Type and Content-Range.
+	Header http.Header
+
+	// Start and End are the offsets of the first and last byte of the
+	// object returned by the response, End is -1 when unknown.
+	Start, End int64
+
+	// TotalSize is the size of the whole object, not just the returned
+	// range, -1 when unknown.
+	TotalSize int64
+}
+
+// Response returns the headers and the returned byte range of the most
+// recent GET request issued for Read, it reports false as long as no
+// data has been read. Unlike Stat, TotalSize is always the size of the
+// whole object, even when a range was requested with SetRange.
+func (o *Object) Response() (ObjectResponse, bool) {
+	if o == nil {
+		return ObjectResponse{}, false
+	}
+	// Locking.
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.respHeader == nil {
+		return ObjectResponse{}, false
+	}
+	resp := ObjectResponse{
+		Header:    o.respHeader.Clone(),
+		End:       -1,
+		TotalSize: -1,
+	}
+	if contentRange := resp.Header.Get("Content-Range"); contentRange != "" {
+		start, end, size, err := parseContentRange(contentRange)
+		if err == nil {
+			resp.Start, resp.End, resp.TotalSize = start, end, size
+		}
+	} else if size, err := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64); err == nil {
+		// The whole object was returned.
+		resp.End, resp.TotalSize = size-1, size
+	}
+	return resp, true
+}
+
 // ReadAt reads len(b) bytes from the File starting at byte offset
 // off. It returns the number of bytes read and the error, if any.
 // ReadAt always returns a non-nil error when n < len(b). At end of
 // file, that error is io.EOF.
+//
+// Each ReadAt call issues its own ranged GET request, it neither
+// affects nor is affected by the offset used by Read and Seek, and
+// it is safe to call ReadAt concurrently from multiple goroutines.
 func (o *Object) ReadAt(b []byte, offset int64) (n int, err error) {
 	if o == nil {
 		return 0, errInvalidArgument("Object is nil")
 	}
+	if offset < 0 {
+		return 0, errInvalidArgument(fmt.Sprintf("Negative offset %d not allowed", offset))
+	}
 
-	// Locking.
+	// Locking, only held to inspect the shared state.
 	o.mutex.Lock()
-	defer o.mutex.Unlock()
-
 	// prevErr is error which was saved in previous operation.
 	if o.prevErr != nil && o.prevErr != io.EOF || o.isClosed {
-		return 0, o.prevErr
+		err = o.prevErr
+		o.mutex.Unlock()
+		return 0, err
 	}
-
-	// Set the current offset to ReadAt offset, because the current offset will be shifted at the end of this method.
-	o.currOffset = offset
+	objectInfo, objectInfoSet := o.objectInfo, o.objectInfoSet
+	o.mutex.Unlock()
 
 	// Can only compare offsets to size when size has been set.
-	if o.objectInfoSet {
-		// If offset is negative than we return io.EOF.
+	if objectInfoSet && objectInfo.Size > -1 && offset >= objectInfo.Size {
 		// If offset is greater than or equal to object size we return io.EOF.
-		if (o.objectInfo.Size > -1 && offset >= o.objectInfo.Size) || offset < 0 {
-			return 0, io.EOF
-		}
+		return 0, io.EOF
 	}
 
-	// Create the new readAt request.
-	readAtReq := getRequest{
-		isReadOp:        true,
-		isReadAt:        true,
-		DidOffsetChange: true,       // Offset always changes.
-		beenRead:        o.beenRead, // Set if this is the first request to try and read.
-		Offset:          offset,     // Set the offset.
-		Buffer:          b,
+	if len(b) == 0 {
+		return 0, nil
 	}
 
-	// Alert that this is the first request.
-	if !o.isStarted {
-		readAtReq.isFirstReq = true
+	var etag string
+	if objectInfoSet {
+		etag = objectInfo.ETag
 	}
+	n, err = o.readAtFn(o.ctx, b, offset, etag)
+	o.reportProgress(b[:n])
+	return n, err
+}
 
-	// Send and receive from the first request.
-	response, err := o.doGetRequest(readAtReq)
-	if err != nil && err != io.EOF {
-		// Save the error.
-		o.prevErr = err
-		return response.Size, err
-	}
-	// Bytes read.
-	bytesRead := int64(response.Size)
-	// There is no valid objectInfo yet
-	// 	to compare against for EOF.
-	if !o.objectInfoSet {
-		// Update the currentOffset.
-		o.currOffset += bytesRead
-	} else {
-		// If this was not the first request update
-		// the offsets and compare against objectInfo
-		// for EOF.
-		oerr := o.setOffset(bytesRead)
-		if oerr != nil {
-			o.prevErr = oerr
-			return response.Size, oerr
-		}
+// reportProgress - reports the data returned to the caller to the
+// progress reader of the object, if any.
+func (o *Object) reportProgress(b []byte) {
+	if o.progress != nil && len(b) > 0 {
+		o.progress.Read(b)
 	}
-	return response.Size, err
 }
 
 // Seek sets the offset for the next Read or Write to offset,
@@ -527,8 +599,8 @@ func (o *Object) Seek(offset int64, whence int) (n int64, err error) {
 		return 0, o.prevErr
 	}
 
-	// Negative offset is valid for whence of '2'.
-	if offset < 0 && whence != 2 {
+	// Negative offset is valid for whence of '1' and '2'.
+	if offset < 0 && whence == 0 {
 		return 0, errInvalidArgument(fmt.Sprintf("Negative position not allowed for %d", whence))
 	}
 
@@ -565,6 +637,10 @@ func (o *Object) Seek(offset int64, whence int) (n int64, err error) {
 		if o.objectInfo.Size > -1 && o.currOffset+offset > o.objectInfo.Size {
 			return 0, io.EOF
 		}
+		// Seeking to negative position not allowed for whence.
+		if o.currOffset+offset < 0 {
+			return 0, errInvalidArgument(fmt.Sprintf("Seeking at negative offset not allowed for %d", whence))
+		}
 		newOffset += offset
 	case 2:
 		// If we don't know the object size return an error for io.SeekEnd
@@ -584,7 +660,7 @@ func (o *Object) Seek(offset int64, whence int) (n int64, err error) {
 		newOffset = o.objectInfo.Size + offset
 	}
 	// Reset the saved error since we successfully seeked, let the Read
-	// and ReadAt decide.
+	// decide.
 	if o.prevErr == io.EOF {
 		o.prevErr = nil
 	}
@@ -639,6 +715,47 @@ func newObject(ctx context.Context, cancel context.CancelFunc, reqCh chan<- getR
 	}
 }
 
+// getObjectRange - reads len(b) bytes of the object starting at
+// offset into b using a single ranged GET request. Returns io.EOF
+// when the object ends before b is filled.
+func (c *Client) getObjectRange(ctx context.Context, bucketName, objectName string, opts GetObjectOptions, b []byte, offset int64) (int, error) {
+	if err := opts.SetRange(offset, offset+int64(len(b))-1); err != nil {
+		return 0, err
+	}
+	httpReader, objectInfo, _, err := c.getObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		// Offset is at or beyond the end of the object.
+		if ToErrorResponse(err).Code == "InvalidRange" {
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	defer httpReader.Close()
+
+	// objectInfo.Size is the length of the returned range here.
+	size, err := readFull(httpReader, b)
+	if size > 0 && err == io.ErrUnexpectedEOF {
+		if objectInfo.Size > -1 && int64(size) < objectInfo.Size {
+			// In situations when returned size
+			// is less than the expected content
+			// length set by the server, make sure
+			// we return io.ErrUnexpectedEOF
+			err = io.ErrUnexpectedEOF
+		} else {
+			// The range extends past the end of the
+			// object, server returned all it has.
+			err = io.EOF
+		}
+	} else if size == 0 && err == io.EOF && objectInfo.Size > 0 {
+		// Special cases when server writes more data
+		// than the content-length, net/http response
+		// body returns an error, instead of converting
+		// it to io.EOF - return unexpected EOF.
+		err = io.ErrUnexpectedEOF
+	}
+	return size, err
+}
+
 // getObject - retrieve object from Object Storage.
 //
 // Additionally this function also takes range arguments to download the specified
@@ -662,6 +779,7 @@ func (c *Client) getObject(ctx context.Context, bucketName, objectName string, o
 		queryValues:      opts.toQueryValues(),
 		customHeader:     opts.Header(),
 		contentSHA256Hex: emptySHA256Hex,
+		bandwidth:        c.objectBandwidth(opts.bandwidth, opts.BandwidthLimit),
 	})
 	if err != nil {
 		return nil, ObjectInfo{}, nil, err
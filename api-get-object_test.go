@@ -18,12 +18,21 @@
 package minio
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"crypto/rand"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestGetObjectReturnSuccess(t *testing.T) {
@@ -146,3 +155,555 @@ func TestGetObjectReturnErrorIfServerSendsMore(t *testing.T) {
 		t.Fatalf("Expected %v, got %v", io.ErrUnexpectedEOF, err)
 	}
 }
+
+func TestGetObjectReadAtConcurrent(t *testing.T) {
+	data := make([]byte, 64*1024)
+	rand.Read(data)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		w.Header().Set("ETag", "\"abc\"")
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := clnt.GetObject(context.Background(), "bucketName", "objectName", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+
+	// Sequential read, must not be disturbed by ReadAt.
+	head := make([]byte, 100)
+	if _, err = io.ReadFull(obj, head); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 16)
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(off int64) {
+			defer wg.Done()
+			buf := make([]byte, 1024)
+			n, err := obj.ReadAt(buf, off)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if !bytes.Equal(buf[:n], data[off:off+int64(n)]) {
+				errs <- fmt.Errorf("mismatched data at offset %d", off)
+			}
+		}(int64(i) * 4000)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	// Reading past the end of the object returns io.EOF.
+	buf := make([]byte, 1024)
+	n, err := obj.ReadAt(buf, int64(len(data))-10)
+	if err != io.EOF {
+		t.Fatalf("Expected %v, got %v", io.EOF, err)
+	}
+	if n != 10 {
+		t.Fatalf("Expected read bytes '10', got %v", n)
+	}
+
+	next := make([]byte, 100)
+	if _, err = io.ReadFull(obj, next); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(next, data[100:200]) {
+		t.Fatal("Read offset was modified by ReadAt")
+	}
+}
+
+func TestGetObjectResponse(t *testing.T) {
+	data := make([]byte, 4096)
+	rand.Read(data)
+
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		w.Header().Set("ETag", "\"abc\"")
+		w.Header().Set("Content-Type", "application/x-test")
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := GetObjectOptions{}
+	opts.SetRange(1000, 1999)
+	obj, err := clnt.GetObject(context.Background(), "bucketName", "objectName", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+
+	if _, ok := obj.Response(); ok {
+		t.Fatal("expected no response before the first read")
+	}
+	buf, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, data[1000:2000]) {
+		t.Fatal("unexpected range content")
+	}
+
+	resp, ok := obj.Response()
+	if !ok {
+		t.Fatal("expected a response after reading")
+	}
+	if resp.Start != 1000 || resp.End != 1999 || resp.TotalSize != int64(len(data)) {
+		t.Errorf("expected range 1000-1999/%d, got %d-%d/%d", len(data), resp.Start, resp.End, resp.TotalSize)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/x-test" {
+		t.Errorf("expected Content-Type application/x-test, got %s", ct)
+	}
+
+	st, err := obj.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.ETag != "abc" || st.ContentType != "application/x-test" {
+		t.Errorf("unexpected object info %+v", st)
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request, got %d", requests)
+	}
+}
+
+func TestFGetObjectParallel(t *testing.T) {
+	data := make([]byte, 40*1024*1024+123)
+	rand.Read(data)
+
+	for _, rangesSupported := range []bool{true, false} {
+		var rangedRequests, fullRequests atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+			w.Header().Set("ETag", "\"abc\"")
+			if r.Method == http.MethodGet {
+				if r.Header.Get("Range") != "" && rangesSupported {
+					rangedRequests.Add(1)
+				} else {
+					fullRequests.Add(1)
+				}
+			}
+			if !rangesSupported {
+				r.Header.Del("Range")
+			}
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+		}))
+
+		clnt, err := New(srv.Listener.Addr().String(), &Options{
+			Region: "us-east-1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		filePath := filepath.Join(t.TempDir(), "object")
+		err = clnt.FGetObject(context.Background(), "bucketName", "objectName", filePath, GetObjectOptions{NumThreads: 4})
+		srv.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile(filePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("ranges supported %v: downloaded content mismatch", rangesSupported)
+		}
+		if rangesSupported && (rangedRequests.Load() != 3 || fullRequests.Load() != 0) {
+			t.Errorf("expected 3 ranged requests, got %d ranged and %d full", rangedRequests.Load(), fullRequests.Load())
+		}
+		if !rangesSupported && fullRequests.Load() == 0 {
+			t.Error("expected a fallback to a single stream")
+		}
+		matches, _ := filepath.Glob(filePath + "*.part.minio")
+		if len(matches) != 0 {
+			t.Errorf("expected part files to be removed, found %v", matches)
+		}
+	}
+}
+
+func TestFGetObjectParallelResume(t *testing.T) {
+	data := make([]byte, 40*1024*1024+123)
+	rand.Read(data)
+	_, partSize, _, err := OptimalPartInfo(int64(len(data)), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		state    fGetObjectState
+		requests int32
+	}{
+		{"resume", fGetObjectState{ETag: "abc", Size: int64(len(data)), PartSize: partSize, Done: []int{0, 2}}, 1},
+		{"changed etag", fGetObjectState{ETag: "old", Size: int64(len(data)), PartSize: partSize, Done: []int{0, 2}}, 3},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var rangedRequests atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+				w.Header().Set("ETag", "\"abc\"")
+				if r.Method == http.MethodGet && r.Header.Get("Range") != "" {
+					rangedRequests.Add(1)
+				}
+				http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+			}))
+			defer srv.Close()
+
+			clnt, err := New(srv.Listener.Addr().String(), &Options{
+				Region: "us-east-1",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Leave behind what a crashed download would have: the
+			// ranges recorded as done and garbage everywhere else.
+			filePath := filepath.Join(t.TempDir(), "object")
+			filePartPath := filePath + sum256Hex([]byte("abc")) + ".part.minio"
+			partial := make([]byte, len(data))
+			for _, partIndex := range testCase.state.Done {
+				start := int64(partIndex) * partSize
+				end := min(start+partSize, int64(len(data)))
+				copy(partial[start:end], data[start:end])
+			}
+			if err = os.WriteFile(filePartPath, partial, 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if err = testCase.state.save(filePartPath + ".json"); err != nil {
+				t.Fatal(err)
+			}
+
+			err = clnt.FGetObject(context.Background(), "bucketName", "objectName", filePath, GetObjectOptions{NumThreads: 4})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(filePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Error("downloaded content mismatch")
+			}
+			if n := rangedRequests.Load(); n != testCase.requests {
+				t.Errorf("expected %d ranged requests, got %d", testCase.requests, n)
+			}
+			if _, err = os.Stat(filePartPath + ".json"); !os.IsNotExist(err) {
+				t.Errorf("expected state file to be removed, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFGetObjectParallelResumeAfterError(t *testing.T) {
+	data := make([]byte, 40*1024*1024+123)
+	rand.Read(data)
+	_, partSize, _, err := OptimalPartInfo(int64(len(data)), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastRange := fmt.Sprintf("bytes=%d-", 2*partSize)
+
+	var failed, rangedRequests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		w.Header().Set("ETag", "\"abc\"")
+		if r.Method == http.MethodGet && r.Header.Get("Range") != "" {
+			rangedRequests.Add(1)
+			// Fail the last range once, after the others are done.
+			if strings.HasPrefix(r.Header.Get("Range"), lastRange) && failed.Add(1) == 1 {
+				time.Sleep(500 * time.Millisecond)
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region:     "us-east-1",
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filePath := filepath.Join(t.TempDir(), "object")
+	filePartPath := filePath + sum256Hex([]byte("abc")) + ".part.minio"
+	if err = clnt.FGetObject(context.Background(), "bucketName", "objectName", filePath, GetObjectOptions{NumThreads: 4}); err == nil {
+		t.Fatal("expected the download to fail")
+	}
+	for _, path := range []string{filePartPath, filePartPath + ".json"} {
+		if _, err = os.Stat(path); err != nil {
+			t.Fatalf("expected %s to be kept to resume with: %v", path, err)
+		}
+	}
+
+	rangedRequests.Store(0)
+	if err = clnt.FGetObject(context.Background(), "bucketName", "objectName", filePath, GetObjectOptions{NumThreads: 4}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Error("downloaded content mismatch")
+	}
+	if n := rangedRequests.Load(); n != 1 {
+		t.Errorf("expected only the failed range to be downloaded again, got %d ranged requests", n)
+	}
+	if _, err = os.Stat(filePartPath + ".json"); !os.IsNotExist(err) {
+		t.Errorf("expected state file to be removed, got %v", err)
+	}
+}
+
+func TestGetObjectZipReader(t *testing.T) {
+	files := map[string][]byte{
+		"a.txt":     bytes.Repeat([]byte("a"), 1000),
+		"dir/b.bin": make([]byte, 64*1024),
+	}
+	rand.Read(files["dir/b.bin"])
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(content)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		w.Header().Set("ETag", "\"abc\"")
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := clnt.GetObject(context.Background(), "bucketName", "objectName", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	st, err := obj.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(obj, st.Size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zr.File) != len(files) {
+		t.Fatalf("expected %d files, got %d", len(files), len(zr.File))
+	}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(content, files[f.Name]) {
+			t.Errorf("content mismatch for %s", f.Name)
+		}
+	}
+
+	// Relative seeks backwards from the current offset.
+	if _, err = obj.Seek(100, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	off, err := obj.Seek(-40, io.SeekCurrent)
+	if err != nil || off != 60 {
+		t.Fatalf("expected offset 60, got %d (%v)", off, err)
+	}
+	b := make([]byte, 10)
+	if _, err = io.ReadFull(obj, b); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, data[60:70]) {
+		t.Error("unexpected content after relative seek")
+	}
+	if _, err = obj.Seek(-100, io.SeekCurrent); err == nil {
+		t.Error("expected error seeking before the start of the object")
+	}
+}
+
+// progressCounter counts the bytes reported to a progress reader.
+type progressCounter struct {
+	n atomic.Int64
+}
+
+func (p *progressCounter) Read(b []byte) (int, error) {
+	p.n.Add(int64(len(b)))
+	return len(b), nil
+}
+
+func TestGetObjectProgress(t *testing.T) {
+	data := make([]byte, 1024*1024+7)
+	rand.Read(data)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		w.Header().Set("ETag", "\"abc\"")
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	progress := &progressCounter{}
+	obj, err := clnt.GetObject(ctx, "bucketName", "objectName", GetObjectOptions{Progress: progress})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.ReadAll(obj); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = obj.ReadAt(make([]byte, 10), 5); err != nil {
+		t.Fatal(err)
+	}
+	if n := progress.n.Load(); n != int64(len(data))+10 {
+		t.Errorf("expected %d bytes of progress, got %d", len(data)+10, n)
+	}
+
+	for _, numThreads := range []uint{0, 2} {
+		filePath := filepath.Join(t.TempDir(), "object")
+		if numThreads == 0 {
+			// Resume a download which stopped half way.
+			filePartPath := filePath + sum256Hex([]byte("abc")) + ".part.minio"
+			if err = os.WriteFile(filePartPath, data[:len(data)/2], 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+		progress = &progressCounter{}
+		err = clnt.FGetObject(ctx, "bucketName", "objectName", filePath, GetObjectOptions{NumThreads: numThreads, Progress: progress})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := progress.n.Load(); n != int64(len(data)) {
+			t.Errorf("threads %d: expected %d bytes of progress, got %d", numThreads, len(data), n)
+		}
+		got, err := os.ReadFile(filePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("threads %d: downloaded content mismatch", numThreads)
+		}
+	}
+}
+
+func TestGetObjectVerifyChecksum(t *testing.T) {
+	data := make([]byte, 100*1024)
+	rand.Read(data)
+
+	testCases := []struct {
+		checksum string
+		mismatch bool
+	}{
+		{ChecksumCRC32C.ChecksumBytes(data).Encoded(), false},
+		{ChecksumCRC32C.ChecksumBytes(data[1:]).Encoded(), true},
+		{ChecksumSHA256.ChecksumBytes(data[1:]).Encoded(), true},
+		// Checksums of multipart objects are not verified.
+		{ChecksumCRC32C.ChecksumBytes(data[1:]).Encoded() + "-2", false},
+	}
+	for i, testCase := range testCases {
+		header := "X-Amz-Checksum-Crc32c"
+		if i == 2 {
+			header = "X-Amz-Checksum-Sha256"
+		}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Amz-Checksum-Mode") != "ENABLED" {
+				t.Errorf("Test %d: expected checksum mode to be enabled", i+1)
+			}
+			w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+			w.Header().Set("ETag", "\"abc\"")
+			w.Header().Set(header, testCase.checksum)
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+		}))
+
+		clnt, err := New(srv.Listener.Addr().String(), &Options{
+			Region: "us-east-1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := GetObjectOptions{VerifyChecksum: true}
+
+		obj, err := clnt.GetObject(context.Background(), "bucketName", "objectName", opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = io.ReadAll(obj)
+		obj.Close()
+		if testCase.mismatch && ToErrorResponse(err).Code != "XAmzContentChecksumMismatch" {
+			t.Errorf("Test %d: expected a checksum mismatch, got %v", i+1, err)
+		}
+		if !testCase.mismatch && err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+		}
+
+		filePath := filepath.Join(t.TempDir(), "object")
+		err = clnt.FGetObject(context.Background(), "bucketName", "objectName", filePath, opts)
+		srv.Close()
+		if testCase.mismatch {
+			if ToErrorResponse(err).Code != "XAmzContentChecksumMismatch" {
+				t.Errorf("Test %d: expected a checksum mismatch, got %v", i+1, err)
+			}
+			if matches, _ := filepath.Glob(filePath + "*"); len(matches) != 0 {
+				t.Errorf("Test %d: expected no files to be left, found %v", i+1, matches)
+			}
+		} else if err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+		}
+	}
+}
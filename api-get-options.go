@@ -19,6 +19,7 @@ package minio
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -48,6 +49,37 @@ type GetObjectOptions struct {
 	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/checking-object-integrity.html
 	Checksum bool
 
+	// VerifyChecksum verifies the data read by GetObject and FGetObject
+	// against the checksum of the whole object stored with it, reading
+	// the end of the object fails with an error if they do not match.
+	// Implies Checksum. Data read from offsets other than sequentially
+	// from the start, and objects stored without a checksum of the whole
+	// object, such as multipart objects, are not verified.
+	VerifyChecksum bool
+
+	// NumThreads sets the number of concurrent ranged requests FGetObject
+	// downloads the object with, a value of 0 or 1 downloads it over a
+	// single stream. A parallel download interrupted by a crash or an
+	// error resumes with the ranges which are still missing, unless the
+	// object has changed. Not used by other calls.
+	NumThreads uint
+
+	// Progress is read with the data downloaded by GetObject and
+	// FGetObject, the same way PutObjectOptions.Progress is read with
+	// the data uploaded, for example to update a progress bar. Data
+	// already present in the part file of a resumed FGetObject is
+	// reported first.
+	Progress io.Reader
+
+	// BandwidthLimit limits the rate in bytes per second of the data
+	// downloaded by GetObject and FGetObject, shared by the ranges
+	// downloaded concurrently, in place of Options.BandwidthLimit of
+	// the client. Not limited if 0.
+	BandwidthLimit int64
+
+	// Limiter of BandwidthLimit shared by the requests of a download.
+	bandwidth *bandwidthLimiter
+
 	// To be not used by external applications
 	Internal AdvancedGetOptions
 }
@@ -70,12 +102,31 @@ func (o GetObjectOptions) Header() http.Header {
 	if o.Internal.ReplicationProxyRequest != "" {
 		headers.Set(minIOBucketReplicationProxyRequest, o.Internal.ReplicationProxyRequest)
 	}
-	if o.Checksum {
+	if o.Checksum || o.VerifyChecksum {
 		headers.Set("x-amz-checksum-mode", "ENABLED")
 	}
 	return headers
 }
 
+// clone returns a copy of the options whose headers and request
+// parameters can be modified without affecting the original.
+func (o GetObjectOptions) clone() GetObjectOptions {
+	c := o
+	if o.headers != nil {
+		c.headers = make(map[string]string, len(o.headers))
+		for k, v := range o.headers {
+			c.headers[k] = v
+		}
+	}
+	if o.reqParams != nil {
+		c.reqParams = make(url.Values, len(o.reqParams))
+		for k, v := range o.reqParams {
+			c.reqParams[k] = append([]string(nil), v...)
+		}
+	}
+	return c
+}
+
 // Set adds a key value pair to the options. The
 // key-value pair will be part of the HTTP GET request
 // headers.
@@ -123,7 +174,8 @@ func (o *GetObjectOptions) SetMatchETag(etag string) error {
 	return nil
 }
 
-// SetMatchETagExcept - set match etag except.
+// SetMatchETagExcept - set match etag except, the request fails with
+// the NotModified error code if the object still has this etag.
 func (o *GetObjectOptions) SetMatchETagExcept(etag string) error {
 	if etag == "" {
 		return errInvalidArgument("ETag cannot be empty.")
@@ -141,7 +193,8 @@ func (o *GetObjectOptions) SetUnmodified(modTime time.Time) error {
 	return nil
 }
 
-// SetModified - set modified time since.
+// SetModified - set modified time since, the request fails with the
+// NotModified error code if the object was not modified since then.
 func (o *GetObjectOptions) SetModified(modTime time.Time) error {
 	if modTime.IsZero() {
 		return errInvalidArgument("Modified since cannot be empty.")
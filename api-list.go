@@ -68,7 +68,7 @@ func (c *Client) listObjectsV2(ctx context.Context, bucketName string, opts List
 	}
 
 	// Return object owner information by default
-	fetchOwner := true
+	fetchOwner := !opts.WithoutOwner
 
 	sendObjectInfo := func(info ObjectInfo) {
 		select {
@@ -99,15 +99,13 @@ func (c *Client) listObjectsV2(ctx context.Context, bucketName string, opts List
 	go func(objectStatCh chan<- ObjectInfo) {
 		defer func() {
 			if contextCanceled(ctx) {
-				objectStatCh <- ObjectInfo{
-					Err: ctx.Err(),
-				}
+				sendLastEntry(objectStatCh, ObjectInfo{Err: ctx.Err()})
 			}
 			close(objectStatCh)
 		}()
 
 		// Save continuationToken for next request.
-		var continuationToken string
+		continuationToken := opts.ContinuationToken
 		for {
 			// Get list of objects a maximum of 1000 per request.
 			result, err := c.listObjectsV2Query(ctx, bucketName, opts.Prefix, continuationToken,
@@ -314,9 +312,7 @@ func (c *Client) listObjects(ctx context.Context, bucketName string, opts ListOb
 	go func(objectStatCh chan<- ObjectInfo) {
 		defer func() {
 			if contextCanceled(ctx) {
-				objectStatCh <- ObjectInfo{
-					Err: ctx.Err(),
-				}
+				sendLastEntry(objectStatCh, ObjectInfo{Err: ctx.Err()})
 			}
 			close(objectStatCh)
 		}()
@@ -411,15 +407,13 @@ func (c *Client) listObjectVersions(ctx context.Context, bucketName string, opts
 	go func(resultCh chan<- ObjectInfo) {
 		defer func() {
 			if contextCanceled(ctx) {
-				resultCh <- ObjectInfo{
-					Err: ctx.Err(),
-				}
+				sendLastEntry(resultCh, ObjectInfo{Err: ctx.Err()})
 			}
 			close(resultCh)
 		}()
 
 		var (
-			keyMarker       = ""
+			keyMarker       = opts.StartAfter
 			versionIDMarker = ""
 		)
 
@@ -470,20 +464,22 @@ func (c *Client) listObjectVersions(ctx context.Context, bucketName string, opts
 				}
 			}
 
-			// If next key marker is present, save it for next request.
-			if result.NextKeyMarker != "" {
-				keyMarker = result.NextKeyMarker
-			}
-
-			// If next version id marker is present, save it for next request.
-			if result.NextVersionIDMarker != "" {
-				versionIDMarker = result.NextVersionIDMarker
-			}
-
 			// Listing ends result is not truncated, return right here.
 			if !result.IsTruncated {
 				return
 			}
+
+			// Add this to catch broken S3 API implementations.
+			if result.NextKeyMarker == "" {
+				sendObjectInfo(ObjectInfo{
+					Err: fmt.Errorf("listObjectVersions is truncated without nextKeyMarker, %s S3 server is incompatible with S3 API", c.endpointURL),
+				})
+				return
+			}
+
+			// Save next key and version id markers for next request.
+			keyMarker = result.NextKeyMarker
+			versionIDMarker = result.NextVersionIDMarker
 		}
 	}(resultCh)
 	return resultCh
@@ -697,9 +693,19 @@ type ListObjectsOptions struct {
 	MaxKeys int
 	// StartAfter start listing lexically at this
 	// object onwards, this value can also be set
-	// for Marker when `UseV1` is set to true.
+	// for Marker when `UseV1` is set to true and
+	// for KeyMarker when `WithVersions` is set.
 	StartAfter string
 
+	// ContinuationToken resumes a listing from the
+	// NextContinuationToken of an earlier ListObjectsV2
+	// response, not used with `UseV1` or `WithVersions`.
+	ContinuationToken string
+
+	// WithoutOwner does not request the owner of
+	// the objects, not used with `UseV1`.
+	WithoutOwner bool
+
 	// Use the deprecated list objects V1 API
 	UseV1 bool
 
@@ -776,6 +782,16 @@ func contextCanceled(ctx context.Context) bool {
 	}
 }
 
+// sendLastEntry delivers the last entry of a listing, e.g. the error of
+// its cancellation. The listing goroutine is the only sender of ch and
+// sends nothing else afterwards, so the entry is queued behind those not
+// yet received instead of replacing one, whatever the buffer size of ch.
+// It is sent once the caller, who must read ch until it is closed,
+// received the entries still buffered.
+func sendLastEntry[T any](ch chan<- T, entry T) {
+	ch <- entry
+}
+
 // listIncompleteUploads lists all incomplete uploads.
 func (c *Client) listIncompleteUploads(ctx context.Context, bucketName, objectPrefix string, recursive bool) <-chan ObjectMultipartInfo {
 	// Allocate channel for multipart uploads.
@@ -802,12 +818,16 @@ func (c *Client) listIncompleteUploads(ctx context.Context, bucketName, objectPr
 		}
 		return objectMultipartStatCh
 	}
+	sendUploadInfo := func(info ObjectMultipartInfo) {
+		select {
+		case objectMultipartStatCh <- info:
+		case <-ctx.Done():
+		}
+	}
 	go func(objectMultipartStatCh chan<- ObjectMultipartInfo) {
 		defer func() {
 			if contextCanceled(ctx) {
-				objectMultipartStatCh <- ObjectMultipartInfo{
-					Err: ctx.Err(),
-				}
+				sendLastEntry(objectMultipartStatCh, ObjectMultipartInfo{Err: ctx.Err()})
 			}
 			close(objectMultipartStatCh)
 		}()
@@ -819,9 +839,9 @@ func (c *Client) listIncompleteUploads(ctx context.Context, bucketName, objectPr
 			// list all multipart uploads.
 			result, err := c.listMultipartUploadsQuery(ctx, bucketName, objectMarker, uploadIDMarker, objectPrefix, delimiter, 0)
 			if err != nil {
-				objectMultipartStatCh <- ObjectMultipartInfo{
+				sendUploadInfo(ObjectMultipartInfo{
 					Err: err,
-				}
+				})
 				return
 			}
 			objectMarker = result.NextKeyMarker
@@ -853,6 +873,14 @@ func (c *Client) listIncompleteUploads(ctx context.Context, bucketName, objectPr
 			if !result.IsTruncated {
 				return
 			}
+
+			// Add this to catch broken S3 API implementations.
+			if objectMarker == "" && uploadIDMarker == "" {
+				sendUploadInfo(ObjectMultipartInfo{
+					Err: fmt.Errorf("listMultipartUploads is truncated without nextKeyMarker, %s S3 server is incompatible with S3 API", c.endpointURL),
+				})
+				return
+			}
 		}
 	}(objectMultipartStatCh)
 	// return.
@@ -949,8 +977,6 @@ func (c *Client) listMultipartUploadsQuery(ctx context.Context, bucketName, keyM
 }
 
 // listObjectParts list all object parts recursively.
-//
-//lint:ignore U1000 Keep this around
 func (c *Client) listObjectParts(ctx context.Context, bucketName, objectName, uploadID string) (partsInfo map[int]ObjectPart, err error) {
 	// Part number marker for the next batch of request.
 	var nextPartNumberMarker int
@@ -973,6 +999,10 @@ func (c *Client) listObjectParts(ctx context.Context, bucketName, objectName, up
 		if !listObjPartsResult.IsTruncated {
 			break
 		}
+		// Add this to catch broken S3 API implementations.
+		if nextPartNumberMarker == 0 {
+			return nil, fmt.Errorf("listObjectParts is truncated without nextPartNumberMarker, %s S3 server is incompatible with S3 API", c.endpointURL)
+		}
 	}
 
 	// Return all the parts.
@@ -0,0 +1,254 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListObjectsV2Options(t *testing.T) {
+	var queries []url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Write([]byte(`<ListBucketResult><Name>bucket</Name><IsTruncated>false</IsTruncated>` +
+			`<Contents><Key>b</Key><Size>1</Size></Contents></ListBucketResult>`))
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		opts       ListObjectsOptions
+		token      string
+		fetchOwner string
+	}{
+		{ListObjectsOptions{Recursive: true}, "", "true"},
+		{ListObjectsOptions{Recursive: true, ContinuationToken: "token"}, "token", "true"},
+		{ListObjectsOptions{Recursive: true, WithoutOwner: true}, "", ""},
+	}
+	for i, testCase := range testCases {
+		queries = nil
+		var keys []string
+		for obj := range c.ListObjects(context.Background(), "bucket", testCase.opts) {
+			if obj.Err != nil {
+				t.Fatalf("Test %d: unexpected error: %v", i+1, obj.Err)
+			}
+			keys = append(keys, obj.Key)
+		}
+		if len(keys) != 1 || keys[0] != "b" {
+			t.Errorf("Test %d: unexpected keys %v", i+1, keys)
+		}
+		if len(queries) != 1 {
+			t.Fatalf("Test %d: expected 1 request, got %d", i+1, len(queries))
+		}
+		if got := queries[0].Get("continuation-token"); got != testCase.token {
+			t.Errorf("Test %d: expected continuation-token %q, got %q", i+1, testCase.token, got)
+		}
+		if got := queries[0].Get("fetch-owner"); got != testCase.fetchOwner {
+			t.Errorf("Test %d: expected fetch-owner %q, got %q", i+1, testCase.fetchOwner, got)
+		}
+	}
+}
+
+func TestListObjectVersionsMarkers(t *testing.T) {
+	var queries []url.Values
+	truncatedWithoutMarker := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		switch {
+		case truncatedWithoutMarker:
+			w.Write([]byte(`<ListVersionsResult><IsTruncated>true</IsTruncated></ListVersionsResult>`))
+		case r.URL.Query().Get("version-id-marker") == "":
+			w.Write([]byte(`<ListVersionsResult><IsTruncated>true</IsTruncated>` +
+				`<NextKeyMarker>b</NextKeyMarker><NextVersionIdMarker>v2</NextVersionIdMarker>` +
+				`<Version><Key>b</Key><VersionId>v2</VersionId></Version></ListVersionsResult>`))
+		default:
+			w.Write([]byte(`<ListVersionsResult><IsTruncated>false</IsTruncated>` +
+				`<DeleteMarker><Key>b</Key><VersionId>v1</VersionId></DeleteMarker></ListVersionsResult>`))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := ListObjectsOptions{WithVersions: true, Recursive: true, StartAfter: "a"}
+	var versions []string
+	for obj := range c.ListObjects(context.Background(), "bucket", opts) {
+		if obj.Err != nil {
+			t.Fatalf("unexpected error: %v", obj.Err)
+		}
+		versions = append(versions, obj.VersionID)
+		if obj.VersionID == "v1" && !obj.IsDeleteMarker {
+			t.Error("expected v1 to be a delete marker")
+		}
+	}
+	if strings.Join(versions, ",") != "v2,v1" {
+		t.Errorf("unexpected versions %v", versions)
+	}
+	if len(queries) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(queries))
+	}
+	if got := queries[0].Get("key-marker"); got != "a" {
+		t.Errorf("expected first key-marker %q, got %q", "a", got)
+	}
+	if got := queries[1].Get("key-marker"); got != "b" {
+		t.Errorf("expected second key-marker %q, got %q", "b", got)
+	}
+	if got := queries[1].Get("version-id-marker"); got != "v2" {
+		t.Errorf("expected second version-id-marker %q, got %q", "v2", got)
+	}
+
+	truncatedWithoutMarker = true
+	queries = nil
+	var gotErr error
+	for obj := range c.ListObjects(context.Background(), "bucket", opts) {
+		gotErr = obj.Err
+	}
+	if gotErr == nil {
+		t.Error("expected an error for a truncated listing without a marker")
+	}
+	if len(queries) != 1 {
+		t.Errorf("expected 1 request, got %d", len(queries))
+	}
+}
+
+func TestListIncompleteUploadsTruncated(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Write([]byte(`<ListMultipartUploadsResult><Bucket>bucket</Bucket><IsTruncated>true</IsTruncated>` +
+			`<Upload><Key>a</Key><UploadId>1</UploadId></Upload></ListMultipartUploadsResult>`))
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	var errs int
+	for upload := range c.ListIncompleteUploads(context.Background(), "bucket", "", true) {
+		if upload.Err != nil {
+			errs++
+			continue
+		}
+		keys = append(keys, upload.Key)
+	}
+	if requests != 1 || errs != 1 || len(keys) != 1 {
+		t.Fatalf("expected one upload and an error after one request, got %v, %d errors after %d requests", keys, errs, requests)
+	}
+}
+
+func TestListObjectsCancel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// An endless listing.
+		fmt.Fprintf(w, `<ListBucketResult><IsTruncated>true</IsTruncated><NextContinuationToken>%s</NextContinuationToken>`, r.URL.Query().Get("continuation-token")+"x")
+		for i := 0; i < 10; i++ {
+			fmt.Fprintf(w, `<Contents><Key>object-%d</Key></Contents>`, i)
+		}
+		w.Write([]byte(`</ListBucketResult>`))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	objectCh := clnt.ListObjects(ctx, "bucket", ListObjectsOptions{Recursive: true})
+	if object := <-objectCh; object.Err != nil {
+		t.Fatal(object.Err)
+	}
+
+	// The listing stops once canceled, even if the caller does not
+	// receive anymore, leaving at most the object already buffered
+	// followed by the error.
+	cancel()
+	time.Sleep(100 * time.Millisecond)
+	var received []ObjectInfo
+	for object := range objectCh {
+		received = append(received, object)
+	}
+	if len(received) == 0 || len(received) > 2 {
+		t.Fatalf("Expected the listing to stop, received %d more entries", len(received))
+	}
+	if len(received) == 2 && received[0].Err != nil {
+		t.Fatalf("Expected the buffered object to be kept, got %v", received[0].Err)
+	}
+	if err := received[len(received)-1].Err; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the listing to end with %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestListObjectsCancelBufferFull(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// An endless listing, answering both V1 and V2 requests.
+		fmt.Fprintf(w, `<ListBucketResult><IsTruncated>true</IsTruncated><NextContinuationToken>x</NextContinuationToken><NextMarker>object-9</NextMarker>`)
+		for i := 0; i < 10; i++ {
+			fmt.Fprintf(w, `<Contents><Key>object-%d</Key></Contents>`, i)
+		}
+		w.Write([]byte(`</ListBucketResult>`))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, useV1 := range []bool{false, true} {
+		ctx, cancel := context.WithCancel(context.Background())
+		objectCh := clnt.ListObjects(ctx, "bucket", ListObjectsOptions{Recursive: true, UseV1: useV1})
+
+		// Let the listing fill the buffer before canceling.
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+		time.Sleep(100 * time.Millisecond)
+
+		var received []ObjectInfo
+		for object := range objectCh {
+			received = append(received, object)
+		}
+		// The buffered object is received before the error.
+		if len(received) != 2 || received[0].Err != nil || received[0].Key == "" {
+			t.Fatalf("UseV1=%v: expected the buffered object and the error, got %+v", useV1, received)
+		}
+		if !errors.Is(received[1].Err, context.Canceled) {
+			t.Fatalf("UseV1=%v: expected the listing to end with %v, got %v", useV1, context.Canceled, received[1].Err)
+		}
+	}
+}
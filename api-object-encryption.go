@@ -0,0 +1,126 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"strconv"
+
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// Object metadata of client-side encrypted objects.
+const (
+	cseAlgorithmHeader = "X-Amz-Meta-Cse-Algorithm"
+	cseKeyHeader       = "X-Amz-Meta-Cse-Key"
+	cseNonceHeader     = "X-Amz-Meta-Cse-Nonce"
+	cseSizeHeader      = "X-Amz-Meta-Cse-Unencrypted-Content-Length"
+)
+
+// PutEncryptedObject - encrypts the data read from reader on the client
+// and uploads it as the object. The data is encrypted with a random
+// content key, which is wrapped with the masterKey and stored in the
+// object metadata along with the unencrypted size. The data is
+// encrypted while it is streamed, objectSize is the unencrypted size
+// or -1 if unknown.
+func (c *Client) PutEncryptedObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64,
+	masterKey encrypt.MasterKey, opts PutObjectOptions,
+) (info UploadInfo, err error) {
+	if masterKey == nil {
+		return UploadInfo{}, errInvalidArgument("Master key for client-side encryption cannot be nil.")
+	}
+	key, nonce, err := encrypt.NewContentKey()
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	wrappedKey, err := masterKey.WrapKey(key)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	encReader, err := encrypt.NewEncryptReader(reader, key, nonce)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+
+	// Do not modify the metadata of the caller.
+	userMetadata := make(map[string]string, len(opts.UserMetadata)+4)
+	for k, v := range opts.UserMetadata {
+		userMetadata[k] = v
+	}
+	userMetadata[cseAlgorithmHeader] = encrypt.ClientSideAlgorithm
+	userMetadata[cseKeyHeader] = base64.StdEncoding.EncodeToString(wrappedKey)
+	userMetadata[cseNonceHeader] = base64.StdEncoding.EncodeToString(nonce)
+	if objectSize >= 0 {
+		userMetadata[cseSizeHeader] = strconv.FormatInt(objectSize, 10)
+	}
+	opts.UserMetadata = userMetadata
+
+	return c.PutObject(ctx, bucketName, objectName, encReader, encrypt.EncryptedSize(objectSize), opts)
+}
+
+// GetEncryptedObject - returns a reader of the decrypted data of an
+// object uploaded with PutEncryptedObject, the content key of the
+// object is unwrapped with the masterKey. The size of the returned
+// ObjectInfo is the unencrypted size. Reads fail if the data was
+// modified or truncated, ranges of the object cannot be read.
+func (c *Client) GetEncryptedObject(ctx context.Context, bucketName, objectName string, masterKey encrypt.MasterKey,
+	opts GetObjectOptions,
+) (io.ReadCloser, ObjectInfo, error) {
+	if masterKey == nil {
+		return nil, ObjectInfo{}, errInvalidArgument("Master key for client-side encryption cannot be nil.")
+	}
+	if opts.PartNumber > 0 || opts.Header().Get("Range") != "" {
+		return nil, ObjectInfo{}, errInvalidArgument("Ranges of client-side encrypted objects cannot be read.")
+	}
+
+	body, objInfo, header, err := c.getObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+	reader, err := func() (io.Reader, error) {
+		if alg := header.Get(cseAlgorithmHeader); alg != encrypt.ClientSideAlgorithm {
+			return nil, errInvalidArgument("Object is not client-side encrypted with " + encrypt.ClientSideAlgorithm + ", found '" + alg + "'.")
+		}
+		wrappedKey, err := base64.StdEncoding.DecodeString(header.Get(cseKeyHeader))
+		if err != nil {
+			return nil, err
+		}
+		nonce, err := base64.StdEncoding.DecodeString(header.Get(cseNonceHeader))
+		if err != nil {
+			return nil, err
+		}
+		if objInfo.Size, err = encrypt.DecryptedSize(objInfo.Size); err != nil {
+			return nil, err
+		}
+		key, err := masterKey.UnwrapKey(wrappedKey)
+		if err != nil {
+			return nil, err
+		}
+		return encrypt.NewDecryptReader(body, key, nonce)
+	}()
+	if err != nil {
+		body.Close()
+		return nil, ObjectInfo{}, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{reader, body}, objInfo, nil
+}
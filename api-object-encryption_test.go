@@ -0,0 +1,118 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+func TestEncryptedObject(t *testing.T) {
+	var (
+		stored []byte
+		header http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			stored, _ = io.ReadAll(r.Body)
+			header = r.Header.Clone()
+			w.Header().Set("ETag", `"etag"`)
+		case http.MethodGet:
+			for k, v := range header {
+				if len(k) > len("X-Amz-Meta-") && k[:len("X-Amz-Meta-")] == "X-Amz-Meta-" {
+					w.Header()[k] = v
+				}
+			}
+			w.Header().Set("ETag", `"etag"`)
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			w.Header().Set("Content-Length", strconv.Itoa(len(stored)))
+			w.Write(stored)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	masterKey, err := encrypt.NewMasterKey(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := make([]byte, 3*encrypt.ClientSideChunkSize+10)
+	if _, err = rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+	userMetadata := map[string]string{"name": "value"}
+	opts := PutObjectOptions{UserMetadata: userMetadata, DisableContentSha256: true}
+	if _, err = c.PutEncryptedObject(context.Background(), "bucket", "object", bytes.NewReader(data), int64(len(data)), masterKey, opts); err != nil {
+		t.Fatal(err)
+	}
+	if len(userMetadata) != 1 {
+		t.Errorf("user metadata of the caller was modified: %v", userMetadata)
+	}
+	if bytes.Contains(stored, data[:64]) {
+		t.Error("object was stored unencrypted")
+	}
+	if got := header.Get(cseSizeHeader); got != strconv.Itoa(len(data)) {
+		t.Errorf("expected unencrypted size %d, got %q", len(data), got)
+	}
+	if header.Get("X-Amz-Meta-Name") != "value" {
+		t.Error("user metadata was not sent")
+	}
+
+	reader, objInfo, err := c.GetEncryptedObject(context.Background(), "bucket", "object", masterKey, GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, decrypted) {
+		t.Error("decrypted object does not match")
+	}
+	if objInfo.Size != int64(len(data)) {
+		t.Errorf("expected size %d, got %d", len(data), objInfo.Size)
+	}
+
+	otherKey, err := encrypt.NewMasterKey(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.GetEncryptedObject(context.Background(), "bucket", "object", otherKey, GetObjectOptions{}); err == nil {
+		t.Error("expected an error with another master key")
+	}
+
+	rangeOpts := GetObjectOptions{}
+	rangeOpts.SetRange(0, 10)
+	if _, _, err = c.GetEncryptedObject(context.Background(), "bucket", "object", masterKey, rangeOpts); err == nil {
+		t.Error("expected an error reading a range")
+	}
+}
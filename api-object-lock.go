@@ -113,6 +113,10 @@ func newObjectLockConfig(mode *RetentionMode, validity *uint, unit *ValidityUnit
 			return nil, fmt.Errorf("invalid validity unit `%v`", unit)
 		}
 
+		if *validity == 0 {
+			return nil, fmt.Errorf("validity must be greater than zero")
+		}
+
 		config.Rule = &struct {
 			DefaultRetention struct {
 				Mode  RetentionMode `xml:"Mode"`
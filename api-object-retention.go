@@ -51,6 +51,11 @@ func newObjectRetention(mode *RetentionMode, date *time.Time) (*objectRetention,
 		objectRetention.Mode = *mode
 	}
 
+	// Both are omitted only to remove a governance retention.
+	if (objectRetention.Mode != "") != (objectRetention.RetainUntilDate != nil) {
+		return nil, fmt.Errorf("retention mode and retain until date must be passed together")
+	}
+
 	return objectRetention, nil
 }
 
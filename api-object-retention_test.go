@@ -0,0 +1,75 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewObjectRetention(t *testing.T) {
+	mode := Governance
+	invalid := RetentionMode("INVALID")
+	date := time.Now().Add(time.Hour)
+	testCases := []struct {
+		mode       *RetentionMode
+		date       *time.Time
+		shouldPass bool
+	}{
+		{&mode, &date, true},
+		{nil, nil, true},
+		{&mode, nil, false},
+		{nil, &date, false},
+		{&invalid, &date, false},
+	}
+	for i, testCase := range testCases {
+		_, err := newObjectRetention(testCase.mode, testCase.date)
+		if testCase.shouldPass && err != nil {
+			t.Errorf("Test %d - expected to pass, failed with %s", i+1, err)
+		}
+		if !testCase.shouldPass && err == nil {
+			t.Errorf("Test %d - expected to fail, but passed", i+1)
+		}
+	}
+}
+
+func TestNewObjectLockConfig(t *testing.T) {
+	mode := Compliance
+	unit := Days
+	one, zero := uint(1), uint(0)
+	testCases := []struct {
+		mode       *RetentionMode
+		validity   *uint
+		unit       *ValidityUnit
+		shouldPass bool
+	}{
+		{&mode, &one, &unit, true},
+		{nil, nil, nil, true},
+		{&mode, &zero, &unit, false},
+		{&mode, nil, &unit, false},
+	}
+	for i, testCase := range testCases {
+		_, err := newObjectLockConfig(testCase.mode, testCase.validity, testCase.unit)
+		if testCase.shouldPass && err != nil {
+			t.Errorf("Test %d - expected to pass, failed with %s", i+1, err)
+		}
+		if !testCase.shouldPass && err == nil {
+			t.Errorf("Test %d - expected to fail, but passed", i+1)
+		}
+	}
+}
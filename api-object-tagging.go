@@ -47,6 +47,12 @@ func (c *Client) PutObjectTagging(ctx context.Context, bucketName, objectName st
 	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
 		return err
 	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return err
+	}
+	if otags == nil {
+		return errInvalidArgument("Object tags cannot be nil, use RemoveObjectTagging to remove them.")
+	}
 
 	// Get resources properly escaped and lined up before
 	// using them in http request.
@@ -99,6 +105,14 @@ type GetObjectTaggingOptions struct {
 // GetObjectTagging fetches object tag(s) with options to target
 // a specific object version in a versioned bucket.
 func (c *Client) GetObjectTagging(ctx context.Context, bucketName, objectName string, opts GetObjectTaggingOptions) (*tags.Tags, error) {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return nil, err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+
 	// Get resources properly escaped and lined up before
 	// using them in http request.
 	urlValues := make(url.Values)
@@ -142,6 +156,14 @@ type RemoveObjectTaggingOptions struct {
 // RemoveObjectTagging removes object tag(s) with options to control a specific object
 // version in a versioned bucket
 func (c *Client) RemoveObjectTagging(ctx context.Context, bucketName, objectName string, opts RemoveObjectTaggingOptions) error {
+	// Input validation.
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		return err
+	}
+	if err := s3utils.CheckValidObjectName(objectName); err != nil {
+		return err
+	}
+
 	// Get resources properly escaped and lined up before
 	// using them in http request.
 	urlValues := make(url.Values)
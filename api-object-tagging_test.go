@@ -0,0 +1,95 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+func TestObjectTagging(t *testing.T) {
+	var (
+		requests int
+		stored   []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("versionId") != "v1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			stored, _ = io.ReadAll(r.Body)
+		case http.MethodGet:
+			w.Write(stored)
+		case http.MethodDelete:
+			stored = nil
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	otags, err := tags.MapToObjectTags(map[string]string{"project": "alpha", "tier": "cold"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.PutObjectTagging(ctx, "bucket", "object", otags, PutObjectTaggingOptions{VersionID: "v1"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetObjectTagging(ctx, "bucket", "object", GetObjectTaggingOptions{VersionID: "v1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.ToMap(), otags.ToMap()) {
+		t.Errorf("expected tags %v, got %v", otags.ToMap(), got.ToMap())
+	}
+	if err = c.RemoveObjectTagging(ctx, "bucket", "object", RemoveObjectTaggingOptions{VersionID: "v1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Invalid input must fail before any request is sent.
+	requests = 0
+	if err = c.PutObjectTagging(ctx, "bucket", "object", nil, PutObjectTaggingOptions{}); err == nil {
+		t.Error("expected an error for nil tags")
+	}
+	if err = c.PutObjectTagging(ctx, "bucket", "", otags, PutObjectTaggingOptions{}); err == nil {
+		t.Error("expected an error for an empty object name")
+	}
+	if _, err = c.GetObjectTagging(ctx, "b", "object", GetObjectTaggingOptions{}); err == nil {
+		t.Error("expected an error for an invalid bucket name")
+	}
+	if err = c.RemoveObjectTagging(ctx, "bucket", "", RemoveObjectTaggingOptions{}); err == nil {
+		t.Error("expected an error for an empty object name")
+	}
+	if requests != 0 {
+		t.Errorf("expected no requests for invalid input, got %d", requests)
+	}
+}
@@ -0,0 +1,306 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+// defaultTreeWorkers - number of files transferred concurrently by
+// FPutTree and FGetTree if not configured.
+const defaultTreeWorkers = 4
+
+// PutTreeOptions are options for FPutTree.
+type PutTreeOptions struct {
+	// NumWorkers is the number of files uploaded concurrently,
+	// defaults to 4.
+	NumWorkers int
+
+	// SkipUnchanged does not upload files which are already stored with
+	// the same size and an ETag which is the MD5 sum of the file. Objects
+	// uploaded in multiple parts or encrypted with SSE-C or SSE-KMS do not
+	// have such an ETag and are always uploaded.
+	SkipUnchanged bool
+
+	// PutOpts are the options of the uploads of the files. The bandwidth
+	// limit is shared by all of the uploads.
+	PutOpts PutObjectOptions
+}
+
+// GetTreeOptions are options for FGetTree.
+type GetTreeOptions struct {
+	// NumWorkers is the number of objects downloaded concurrently,
+	// defaults to 4.
+	NumWorkers int
+
+	// SkipUnchanged does not download objects whose file is already
+	// present with the same size and an MD5 sum matching the ETag of the
+	// object, see PutTreeOptions.SkipUnchanged.
+	SkipUnchanged bool
+
+	// GetOpts are the options of the downloads of the objects. The
+	// bandwidth limit is shared by all of the downloads.
+	GetOpts GetObjectOptions
+}
+
+// TreeResult is the result of the transfer of a single file
+// by FPutTree or FGetTree.
+type TreeResult struct {
+	// Key is the object key, Path the local file path.
+	Key  string
+	Path string
+
+	// Size is the size of the file.
+	Size int64
+
+	// Skipped is set if the file was not transferred
+	// because it is unchanged.
+	Skipped bool
+
+	Err error
+}
+
+// treeJob - a file to be transferred by a worker of FPutTree or FGetTree.
+type treeJob struct {
+	key  string
+	path string
+	size int64
+	etag string
+}
+
+// treePrefix - returns the prefix of the keys of a tree, a prefix is
+// always a directory and ends with '/' if not empty.
+func treePrefix(prefix string) string {
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix
+}
+
+// FPutTree uploads the regular files below the directory dirPath
+// concurrently, the key of an object is the prefix followed by the
+// path of the file relative to dirPath with '/' separators. A prefix
+// without a trailing '/' is a directory as well. The result of every
+// file is sent on the returned channel, which must be read until it
+// is closed. A result with only an error ends a canceled transfer.
+func (c *Client) FPutTree(ctx context.Context, bucketName, prefix, dirPath string, opts PutTreeOptions) <-chan TreeResult {
+	resultCh := make(chan TreeResult, 1)
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		defer close(resultCh)
+		resultCh <- TreeResult{Err: err}
+		return resultCh
+	}
+	if err := s3utils.CheckValidObjectNamePrefix(prefix); err != nil {
+		defer close(resultCh)
+		resultCh <- TreeResult{Err: err}
+		return resultCh
+	}
+	if err := opts.PutOpts.validate(); err != nil {
+		defer close(resultCh)
+		resultCh <- TreeResult{Err: err}
+		return resultCh
+	}
+	opts.PutOpts.bandwidth = c.objectBandwidth(opts.PutOpts.bandwidth, opts.PutOpts.BandwidthLimit)
+	prefix = treePrefix(prefix)
+
+	walk := func(jobCh chan<- treeJob) error {
+		return filepath.WalkDir(dirPath, func(path string, d fs.DirEntry, err error) error {
+			if err == nil && !d.Type().IsRegular() {
+				return nil
+			}
+			job := treeJob{path: path}
+			if err == nil {
+				var rel string
+				if rel, err = filepath.Rel(dirPath, path); err == nil {
+					job.key = prefix + filepath.ToSlash(rel)
+					err = s3utils.CheckValidObjectName(job.key)
+				}
+			}
+			if err != nil {
+				select {
+				case resultCh <- TreeResult{Key: job.key, Path: path, Err: err}:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			select {
+			case jobCh <- job:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}
+
+	c.runTree(ctx, opts.NumWorkers, resultCh, walk, func(job treeJob) TreeResult {
+		result := TreeResult{Key: job.key, Path: job.path}
+		st, err := os.Stat(job.path)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		result.Size = st.Size()
+		if opts.SkipUnchanged {
+			objInfo, err := c.StatObject(ctx, bucketName, job.key, StatObjectOptions{})
+			if err == nil && objInfo.Size == result.Size {
+				if result.Skipped, result.Err = c.isFileUnchanged(job.path, objInfo.ETag); result.Skipped || result.Err != nil {
+					return result
+				}
+			}
+		}
+		// Uploads modify the metadata of their options.
+		_, result.Err = c.FPutObject(ctx, bucketName, job.key, job.path, opts.PutOpts.clone())
+		return result
+	})
+	return resultCh
+}
+
+// FGetTree downloads the objects with the prefix concurrently to the
+// directory dirPath, the path of a file is the key of the object with
+// the prefix removed, relative to dirPath. A prefix without a trailing
+// '/' is a directory as well. Directory objects are not downloaded,
+// objects whose key would be outside of dirPath are reported with an
+// error. The result of every object is sent on the returned channel,
+// which must be read until it is closed. A result with only an error
+// ends a failed listing or a canceled transfer.
+func (c *Client) FGetTree(ctx context.Context, bucketName, prefix, dirPath string, opts GetTreeOptions) <-chan TreeResult {
+	resultCh := make(chan TreeResult, 1)
+	if err := s3utils.CheckValidBucketName(bucketName); err != nil {
+		defer close(resultCh)
+		resultCh <- TreeResult{Err: err}
+		return resultCh
+	}
+	if err := s3utils.CheckValidObjectNamePrefix(prefix); err != nil {
+		defer close(resultCh)
+		resultCh <- TreeResult{Err: err}
+		return resultCh
+	}
+	opts.GetOpts.bandwidth = c.objectBandwidth(opts.GetOpts.bandwidth, opts.GetOpts.BandwidthLimit)
+	prefix = treePrefix(prefix)
+
+	list := func(jobCh chan<- treeJob) error {
+		for obj := range c.ListObjects(ctx, bucketName, ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+			if obj.Err != nil {
+				// Ends the listing, including its cancellation.
+				return obj.Err
+			}
+			if strings.HasSuffix(obj.Key, "/") {
+				continue
+			}
+			rel := filepath.FromSlash(strings.TrimPrefix(obj.Key, prefix))
+			if !filepath.IsLocal(rel) {
+				select {
+				case resultCh <- TreeResult{Key: obj.Key, Err: errInvalidArgument("Object " + obj.Key + " is outside of the directory.")}:
+					continue
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			select {
+			case jobCh <- treeJob{key: obj.Key, path: filepath.Join(dirPath, rel), size: obj.Size, etag: obj.ETag}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	}
+
+	c.runTree(ctx, opts.NumWorkers, resultCh, list, func(job treeJob) TreeResult {
+		result := TreeResult{Key: job.key, Path: job.path, Size: job.size}
+		if opts.SkipUnchanged {
+			if st, err := os.Stat(job.path); err == nil && st.Mode().IsRegular() && st.Size() == job.size {
+				if result.Skipped, result.Err = c.isFileUnchanged(job.path, job.etag); result.Skipped || result.Err != nil {
+					return result
+				}
+			}
+		}
+		// Downloads modify the headers of their options.
+		result.Err = c.FGetObject(ctx, bucketName, job.key, job.path, opts.GetOpts.clone())
+		return result
+	})
+	return resultCh
+}
+
+// runTree - transfers the files sent by produce with numWorkers
+// concurrent workers and sends the results on resultCh. An error
+// returned by produce, or else the error of a canceled ctx, is sent
+// last once all of the files have been transferred, then resultCh
+// is closed.
+func (c *Client) runTree(ctx context.Context, numWorkers int, resultCh chan<- TreeResult, produce func(jobCh chan<- treeJob) error, transfer func(treeJob) TreeResult) {
+	if numWorkers <= 0 {
+		numWorkers = defaultTreeWorkers
+	}
+	jobCh := make(chan treeJob)
+	var produceErr error
+	go func() {
+		defer close(jobCh)
+		produceErr = produce(jobCh)
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go func() {
+			defer wg.Done()
+			for job := range jobCh {
+				resultCh <- transfer(job)
+			}
+		}()
+	}
+	go func() {
+		// The workers are done once jobCh is closed by produce.
+		wg.Wait()
+		if produceErr == nil {
+			produceErr = ctx.Err()
+		}
+		if produceErr != nil {
+			resultCh <- TreeResult{Err: produceErr}
+		}
+		close(resultCh)
+	}()
+}
+
+// isFileUnchanged - verifies if the MD5 sum of the file matches the
+// ETag of an object uploaded in a single part.
+func (c *Client) isFileUnchanged(filePath, etag string) (bool, error) {
+	etag = trimEtag(etag)
+	if len(etag) != hex.EncodedLen(16) {
+		return false, nil
+	}
+	f, err := os.Open(filePath)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	hash := c.md5Hasher()
+	defer hash.Close()
+	if _, err = io.Copy(hash, f); err != nil {
+		return false, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)) == etag, nil
+}
@@ -0,0 +1,375 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// treeTestServer - serves objects stored in memory, with their MD5 sum
+// as ETag.
+func treeTestServer(objects map[string][]byte, puts *int) *httptest.Server {
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := strings.TrimPrefix(r.URL.Path, "/bucket/")
+		if r.URL.Query().Get("list-type") == "2" {
+			var keys []string
+			for k := range objects {
+				if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			body := `<ListBucketResult><Name>bucket</Name><IsTruncated>false</IsTruncated>`
+			for _, k := range keys {
+				sum := md5.Sum(objects[k])
+				body += fmt.Sprintf(`<Contents><Key>%s</Key><Size>%d</Size><ETag>"%s"</ETag></Contents>`, k, len(objects[k]), hex.EncodeToString(sum[:]))
+			}
+			io.WriteString(w, body+`</ListBucketResult>`)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			objects[key] = data
+			*puts++
+			sum := md5.Sum(data)
+			w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+		case http.MethodHead, http.MethodGet:
+			data, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			sum := md5.Sum(data)
+			w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			var start int
+			if rng := r.Header.Get("Range"); rng != "" {
+				// Only "bytes=start-" ranges are requested.
+				start, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rng, "bytes="), "-"))
+				w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(data)-1, len(data)))
+				w.Header().Set("Content-Length", strconv.Itoa(len(data)-start))
+				w.WriteHeader(http.StatusPartialContent)
+			} else {
+				w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			}
+			if r.Method == http.MethodGet {
+				w.Write(data[start:])
+			}
+		}
+	}))
+}
+
+func TestFPutTreeFGetTree(t *testing.T) {
+	objects := make(map[string][]byte)
+	var puts int
+	srv := treeTestServer(objects, &puts)
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srcDir := t.TempDir()
+	files := map[string]string{"a.txt": "aaa", "sub/b.txt": "bb", "sub/deep/c.txt": "c"}
+	for name, data := range files {
+		path := filepath.Join(srcDir, filepath.FromSlash(name))
+		if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	putOpts := PutTreeOptions{NumWorkers: 2, SkipUnchanged: true, PutOpts: PutObjectOptions{DisableContentSha256: true}}
+	for result := range c.FPutTree(context.Background(), "bucket", "backup/", srcDir, putOpts) {
+		if result.Err != nil || result.Skipped {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+	}
+	for name, data := range files {
+		if string(objects["backup/"+name]) != data {
+			t.Fatalf("expected object backup/%s to be %q, got %q", name, data, objects["backup/"+name])
+		}
+	}
+
+	// Unchanged files are not uploaded again.
+	if err = os.WriteFile(filepath.Join(srcDir, "a.txt"), []byte("AAA"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	puts = 0
+	skipped := 0
+	for result := range c.FPutTree(context.Background(), "bucket", "backup/", srcDir, putOpts) {
+		if result.Err != nil {
+			t.Fatal(result.Err)
+		}
+		if result.Skipped {
+			skipped++
+		}
+	}
+	if puts != 1 || skipped != 2 || string(objects["backup/a.txt"]) != "AAA" {
+		t.Fatalf("expected 1 upload and 2 skipped files, got %d and %d", puts, skipped)
+	}
+
+	// Objects outside of the directory and directory objects are not downloaded.
+	objects["backup/../escape.txt"] = []byte("x")
+	objects["backup/dir/"] = nil
+	dstDir := t.TempDir()
+	var escaped bool
+	for result := range c.FGetTree(context.Background(), "bucket", "backup/", dstDir, GetTreeOptions{NumWorkers: 2}) {
+		if result.Key == "backup/../escape.txt" {
+			escaped = result.Err == nil
+			continue
+		}
+		if result.Err != nil {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+	}
+	if escaped {
+		t.Fatal("expected an error for an object outside of the directory")
+	}
+	files["a.txt"] = "AAA"
+	for name, data := range files {
+		got, err := os.ReadFile(filepath.Join(dstDir, filepath.FromSlash(name)))
+		if err != nil || string(got) != data {
+			t.Fatalf("expected file %s to be %q, got %q, %v", name, data, got, err)
+		}
+	}
+	if _, err = os.Stat(filepath.Join(filepath.Dir(dstDir), "escape.txt")); err == nil {
+		t.Fatal("expected no file outside of the directory")
+	}
+
+	skipped = 0
+	for result := range c.FGetTree(context.Background(), "bucket", "backup/sub/", dstDir, GetTreeOptions{SkipUnchanged: true}) {
+		if result.Err != nil {
+			t.Fatal(result.Err)
+		}
+		if result.Skipped {
+			skipped++
+		}
+	}
+	if skipped != 0 {
+		t.Fatalf("expected no skipped files in a new layout, got %d", skipped)
+	}
+	for result := range c.FGetTree(context.Background(), "bucket", "backup/sub/", dstDir, GetTreeOptions{SkipUnchanged: true}) {
+		if result.Err != nil || !result.Skipped {
+			t.Fatalf("expected a skipped file, got %+v", result)
+		}
+	}
+}
+
+func TestFPutTreeFGetTreeSharedOptions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case r.Method == http.MethodPost && query.Has("uploads"):
+			io.WriteString(w, `<InitiateMultipartUploadResult><UploadId>upload</UploadId></InitiateMultipartUploadResult>`)
+		case r.Method == http.MethodPost:
+			io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>key</Key><ETag>"etag-2"</ETag></CompleteMultipartUploadResult>`)
+		default:
+			io.Copy(io.Discard, r.Body)
+			w.Header().Set("ETag", `"etag"`)
+		}
+	}))
+	defer srv.Close()
+
+	// Multipart uploads with trailing checksums add to the metadata.
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1", TrailingHeaders: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcDir := t.TempDir()
+	for i := 0; i < 4; i++ {
+		if err = os.WriteFile(filepath.Join(srcDir, strconv.Itoa(i)), make([]byte, absMinPartSize+1), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	putOpts := PutTreeOptions{PutOpts: PutObjectOptions{
+		UserMetadata: map[string]string{"X-Amz-Meta-Tree": "1"},
+		PartSize:     absMinPartSize,
+	}}
+	for result := range c.FPutTree(context.Background(), "bucket", "", srcDir, putOpts) {
+		if result.Err != nil {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+	}
+	if len(putOpts.PutOpts.UserMetadata) != 1 {
+		t.Fatalf("expected the metadata of the caller to be unchanged, got %v", putOpts.PutOpts.UserMetadata)
+	}
+
+	// Resumed downloads set the range of their options.
+	objects := make(map[string][]byte)
+	var puts int
+	getSrv := treeTestServer(objects, &puts)
+	defer getSrv.Close()
+	c, err = New(getSrv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dstDir := t.TempDir()
+	for i := 0; i < 4; i++ {
+		name := strconv.Itoa(i)
+		objects[name] = []byte("data-" + name)
+		sum := md5.Sum(objects[name])
+		partPath := filepath.Join(dstDir, name) + sum256Hex([]byte(hex.EncodeToString(sum[:]))) + ".part.minio"
+		if err = os.WriteFile(partPath, []byte("da"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	getOpts := GetTreeOptions{}
+	getOpts.GetOpts.Set("X-Tree", "1")
+	for result := range c.FGetTree(context.Background(), "bucket", "", dstDir, getOpts) {
+		if result.Err != nil {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+	}
+	for name, data := range objects {
+		if got, err := os.ReadFile(filepath.Join(dstDir, name)); err != nil || string(got) != string(data) {
+			t.Fatalf("expected file %s to be %q, got %q, %v", name, data, got, err)
+		}
+	}
+	if len(getOpts.GetOpts.headers) != 1 {
+		t.Fatalf("expected the headers of the caller to be unchanged, got %v", getOpts.GetOpts.headers)
+	}
+}
+
+func TestFPutTreeFGetTreePrefixDirectory(t *testing.T) {
+	objects := map[string][]byte{"photos": []byte("x"), "photosx": []byte("y")}
+	var puts int
+	srv := treeTestServer(objects, &puts)
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcDir := t.TempDir()
+	if err = os.WriteFile(filepath.Join(srcDir, "a.jpg"), []byte("a"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for result := range c.FPutTree(context.Background(), "bucket", "photos", srcDir, PutTreeOptions{PutOpts: PutObjectOptions{DisableContentSha256: true}}) {
+		if result.Err != nil {
+			t.Fatal(result.Err)
+		}
+		if result.Key != "photos/a.jpg" {
+			t.Fatalf("expected the key 'photos/a.jpg', got %q", result.Key)
+		}
+	}
+
+	// Only the objects below the directory are downloaded.
+	dstDir := t.TempDir()
+	var keys []string
+	for result := range c.FGetTree(context.Background(), "bucket", "photos", dstDir, GetTreeOptions{}) {
+		if result.Err != nil {
+			t.Fatal(result.Err)
+		}
+		keys = append(keys, result.Key)
+	}
+	if len(keys) != 1 || keys[0] != "photos/a.jpg" {
+		t.Fatalf("expected only 'photos/a.jpg' to be downloaded, got %v", keys)
+	}
+	if data, err := os.ReadFile(filepath.Join(dstDir, "a.jpg")); err != nil || string(data) != "a" {
+		t.Fatalf("expected the file 'a.jpg', got %q, %v", data, err)
+	}
+}
+
+func TestFPutTreeInvalidKey(t *testing.T) {
+	var puts int
+	srv := treeTestServer(map[string][]byte{}, &puts)
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcDir := t.TempDir()
+	for _, name := range []string{"a", "long"} {
+		if err = os.WriteFile(filepath.Join(srcDir, name), []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Only the key of 'long' exceeds 1024 characters.
+	prefix := strings.Repeat("p", 1020) + "/"
+	results := make(map[string]error)
+	for result := range c.FPutTree(context.Background(), "bucket", prefix, srcDir, PutTreeOptions{PutOpts: PutObjectOptions{DisableContentSha256: true}}) {
+		results[filepath.Base(result.Path)] = result.Err
+	}
+	if err, ok := results["a"]; !ok || err != nil {
+		t.Fatalf("expected 'a' to be uploaded, got %v", err)
+	}
+	if err := results["long"]; err == nil {
+		t.Fatal("expected an error for the key of 'long'")
+	}
+	if puts != 1 {
+		t.Fatalf("expected 1 upload, got %d", puts)
+	}
+}
+
+func TestTreeCanceled(t *testing.T) {
+	objects := map[string][]byte{"backup/a": []byte("a"), "backup/b": []byte("b")}
+	var puts int
+	srv := treeTestServer(objects, &puts)
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcDir := t.TempDir()
+	for _, name := range []string{"a", "b"} {
+		if err = os.WriteFile(filepath.Join(srcDir, name), []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var last TreeResult
+	for result := range c.FPutTree(ctx, "bucket", "backup/", srcDir, PutTreeOptions{}) {
+		last = result
+	}
+	if last.Key != "" || !errors.Is(last.Err, context.Canceled) {
+		t.Fatalf("expected the upload to end with %v, got %+v", context.Canceled, last)
+	}
+	last = TreeResult{}
+	for result := range c.FGetTree(ctx, "bucket", "backup/", t.TempDir(), GetTreeOptions{}) {
+		last = result
+	}
+	if last.Key != "" || !errors.Is(last.Err, context.Canceled) {
+		t.Fatalf("expected the download to end with %v, got %+v", context.Canceled, last)
+	}
+}
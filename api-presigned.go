@@ -24,6 +24,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 	"github.com/minio/minio-go/v7/pkg/signer"
 )
@@ -152,6 +153,11 @@ func (c *Client) PresignedPostPolicy(ctx context.Context, p *PostPolicy) (u *url
 		secretAccessKey = credValues.SecretAccessKey
 	)
 
+	// Custom signer set then override the behavior.
+	if c.overrideSignerType != credentials.SignatureDefault && !signerType.IsAnonymous() {
+		signerType = c.overrideSignerType
+	}
+
 	if signerType.IsAnonymous() {
 		return nil, nil, errInvalidArgument("Presigned operations are not supported for anonymous credentials")
 	}
@@ -0,0 +1,57 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestPresignedGetObjectResponseOverrides(t *testing.T) {
+	reqParams := make(url.Values)
+	reqParams.Set("response-content-disposition", `attachment; filename="report 2024.pdf"`)
+	reqParams.Set("response-content-type", "application/pdf")
+	reqParams.Set("response-cache-control", "no-cache")
+
+	for _, creds := range []*credentials.Credentials{
+		credentials.NewStaticV4("access", "secret12345", ""),
+		credentials.NewStaticV2("access", "secret12345", ""),
+	} {
+		c, err := New("localhost:9000", &Options{Creds: creds, Region: "us-east-1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := c.PresignedGetObject(context.Background(), "bucket", "object", time.Hour, reqParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		query := u.Query()
+		for k := range reqParams {
+			if got := query.Get(k); got != reqParams.Get(k) {
+				t.Errorf("expected %s=%q, got %q", k, reqParams.Get(k), got)
+			}
+		}
+		if query.Get("X-Amz-Signature") == "" && query.Get("Signature") == "" {
+			t.Errorf("presigned URL is not signed: %s", u)
+		}
+	}
+}
@@ -19,6 +19,8 @@ package minio
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"io"
 	"math"
 	"os"
@@ -129,6 +131,44 @@ func OptimalPartInfo(objectSize int64, configuredPartSize uint64) (totalPartsCou
 	return totalPartsCount, partSize, lastPartSize, nil
 }
 
+// minPartBufferSize - initial size of the buffer used to read parts of
+// streams with unknown size, grown up to the part size as data arrives.
+const minPartBufferSize = 1024 * 1024
+
+// readPartFull - reads up to partSize bytes from reader into buf with
+// the semantics of readFull. Unlike readFull the buffer is grown as
+// data arrives, so that short streams do not allocate a whole part up
+// front. Returns the buffer, which may have been reallocated and is
+// meant to be passed back for the next part.
+func readPartFull(reader io.Reader, buf []byte, partSize int64) ([]byte, int, error) {
+	if int64(cap(buf)) >= partSize {
+		buf = buf[:partSize]
+		n, err := readFull(reader, buf)
+		return buf, n, err
+	}
+	if len(buf) == 0 {
+		buf = make([]byte, int(math.Min(float64(minPartBufferSize), float64(partSize))))
+	}
+	var n int
+	for {
+		m, err := readFull(reader, buf[n:])
+		n += m
+		if err != nil {
+			if err == io.EOF && n > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return buf, n, err
+		}
+		if int64(len(buf)) >= partSize {
+			return buf, n, nil
+		}
+		// Buffer is full, grow it towards the part size.
+		nbuf := make([]byte, int(math.Min(float64(2*len(buf)), float64(partSize))))
+		copy(nbuf, buf[:n])
+		buf = nbuf
+	}
+}
+
 // getUploadID - fetch upload id if already present for an object name
 // or initiate a new request to fetch a new upload id.
 func (c *Client) newUploadID(ctx context.Context, bucketName, objectName string, opts PutObjectOptions) (uploadID string, err error) {
@@ -147,3 +187,103 @@ func (c *Client) newUploadID(ctx context.Context, bucketName, objectName string,
 	}
 	return initMultipartUploadResult.UploadID, nil
 }
+
+// resumeUploadID - returns the upload ID and the already uploaded parts
+// of an incomplete multipart upload of the object, opts.ResumeUploadID
+// is used if set, otherwise the most recent incomplete upload. A new
+// multipart upload is initiated when there is nothing to resume.
+func (c *Client) resumeUploadID(ctx context.Context, bucketName, objectName string, opts PutObjectOptions) (uploadID string, partsInfo map[int]ObjectPart, err error) {
+	uploadID = opts.ResumeUploadID
+	if uploadID == "" {
+		uploadIDs, err := c.findUploadIDs(ctx, bucketName, objectName)
+		if err != nil {
+			return "", nil, err
+		}
+		// Uploads are listed in the order they were initiated.
+		if len(uploadIDs) > 0 {
+			uploadID = uploadIDs[len(uploadIDs)-1]
+		}
+	}
+	if uploadID == "" {
+		uploadID, err = c.newUploadID(ctx, bucketName, objectName, opts)
+		return uploadID, nil, err
+	}
+	partsInfo, err = c.listObjectParts(ctx, bucketName, objectName, uploadID)
+	if err != nil {
+		return "", nil, err
+	}
+	return uploadID, partsInfo, nil
+}
+
+// isPartUploaded - verifies if the uploaded part has the same size and
+// ETag as the local part data read from reader.
+func (c *Client) isPartUploaded(reader *io.SectionReader, part ObjectPart) (bool, error) {
+	if part.Size != reader.Size() {
+		return false, nil
+	}
+	hash := c.md5Hasher()
+	defer hash.Close()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return false, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)) == part.ETag, nil
+}
+
+// putObjectSession - parts of a resumable multipart upload recorded in
+// PutObjectOptions.ResumeStateFile.
+type putObjectSession struct {
+	Bucket      string         `json:"bucket"`
+	Object      string         `json:"object"`
+	Size        int64          `json:"size"`
+	PartSize    int64          `json:"partSize"`
+	Fingerprint string         `json:"fingerprint,omitempty"`
+	UploadID    string         `json:"uploadId"`
+	Parts       []CompletePart `json:"parts,omitempty"`
+}
+
+// loadPutObjectSession - loads the upload recorded by an earlier,
+// interrupted, upload of the same content, returns a session with an
+// empty upload ID if there is none.
+func loadPutObjectSession(sessionPath string, session putObjectSession) putObjectSession {
+	sessionBytes, err := os.ReadFile(sessionPath)
+	if err != nil {
+		return session
+	}
+	var saved putObjectSession
+	if err = json.Unmarshal(sessionBytes, &saved); err != nil {
+		return session
+	}
+	if saved.Bucket != session.Bucket || saved.Object != session.Object || saved.Size != session.Size ||
+		saved.PartSize != session.PartSize || saved.Fingerprint != session.Fingerprint {
+		return session
+	}
+	return saved
+}
+
+// save - records the uploaded parts, the session is written to a
+// temporary file first so that a crash never leaves it truncated.
+func (session putObjectSession) save(sessionPath string) error {
+	sessionBytes, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(sessionPath+".tmp", sessionBytes, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(sessionPath+".tmp", sessionPath)
+}
+
+// isRecorded - verifies if the part was recorded as uploaded with the
+// same ETag, the content is identified by a fingerprint so that it need
+// not be read again.
+func (session putObjectSession) isRecorded(part ObjectPart) bool {
+	if session.Fingerprint == "" {
+		return false
+	}
+	for _, recorded := range session.Parts {
+		if recorded.PartNumber == part.PartNumber {
+			return trimEtag(recorded.ETag) == trimEtag(part.ETag)
+		}
+	}
+	return false
+}
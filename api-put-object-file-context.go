@@ -22,6 +22,7 @@ import (
 	"mime"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 )
@@ -60,5 +61,8 @@ func (c *Client) FPutObject(ctx context.Context, bucketName, objectName, filePat
 			opts.ContentType = "application/octet-stream"
 		}
 	}
+	// Parts recorded for a resumed upload are only trusted as long as
+	// the file was not modified since.
+	opts.resumeFingerprint = strconv.FormatInt(fileSize, 10) + "-" + strconv.FormatInt(fileStat.ModTime().UnixNano(), 10)
 	return c.PutObject(ctx, bucketName, objectName, fileReader, fileSize, opts)
 }
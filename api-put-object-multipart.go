@@ -109,8 +109,8 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 	// Initialize parts uploaded map.
 	partsInfo := make(map[int]ObjectPart)
 
-	// Create a buffer.
-	buf := make([]byte, partSize)
+	// Create a buffer, allocated as the first part is read.
+	var buf []byte
 
 	// Create checksums
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
@@ -118,7 +118,11 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 	customHeader := make(http.Header)
 	crc := crc32.New(crc32.MakeTable(crc32.Castagnoli))
 	for partNumber <= totalPartsCount {
-		length, rErr := readFull(reader, buf)
+		var (
+			length int
+			rErr   error
+		)
+		buf, length, rErr = readPartFull(reader, buf, partSize)
 		if rErr == io.EOF && partNumber > 1 {
 			break
 		}
@@ -158,7 +162,7 @@ func (c *Client) putObjectMultipartNoStream(ctx context.Context, bucketName, obj
 			crcBytes = append(crcBytes, cSum...)
 		}
 
-		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, sha256Hex: sha256Hex, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
+		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, sha256Hex: sha256Hex, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader, bandwidth: opts.bandwidth}
 		// Proceed to upload the part.
 		objPart, uerr := c.uploadPart(ctx, p)
 		if uerr != nil {
@@ -284,6 +288,7 @@ type uploadPartParams struct {
 	streamSha256 bool
 	customHeader http.Header
 	trailer      http.Header
+	bandwidth    *bandwidthLimiter
 }
 
 // uploadPart - Uploads a part in a multipart upload.
@@ -338,6 +343,7 @@ func (c *Client) uploadPart(ctx context.Context, p uploadPartParams) (ObjectPart
 		contentSHA256Hex: p.sha256Hex,
 		streamSha256:     p.streamSha256,
 		trailer:          p.trailer,
+		bandwidth:        c.objectBandwidth(p.bandwidth, 0),
 	}
 
 	// Execute PUT on each part.
@@ -26,6 +26,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"sort"
 	"strings"
 	"sync"
@@ -117,23 +118,62 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 		}
 		opts.UserMetadata["X-Amz-Checksum-Algorithm"] = "CRC32C"
 	}
-	// Initiate a new multipart upload.
-	uploadID, err := c.newUploadID(ctx, bucketName, objectName, opts)
+	var uploadID string
+	// Parts already uploaded of the multipart upload being resumed.
+	var partsInfo map[int]ObjectPart
+	resume := opts.ResumeMultipart
+	// Identifies the upload recorded in the session file.
+	session := putObjectSession{
+		Bucket:      bucketName,
+		Object:      objectName,
+		Size:        size,
+		PartSize:    partSize,
+		Fingerprint: opts.resumeFingerprint,
+	}
+	var sessionPath string
+	if resume {
+		sessionPath = opts.ResumeStateFile
+	}
+	if sessionPath != "" && opts.ResumeUploadID == "" {
+		// Continue the upload recorded in the session file, if any.
+		session = loadPutObjectSession(sessionPath, session)
+		opts.ResumeUploadID = session.UploadID
+	}
+	if resume {
+		// Continue an incomplete upload, if any.
+		uploadID, partsInfo, err = c.resumeUploadID(ctx, bucketName, objectName, opts)
+		if session.UploadID != "" && ToErrorResponse(err).Code == "NoSuchUpload" {
+			// The recorded upload was aborted or completed meanwhile.
+			session.UploadID, session.Parts = "", nil
+			opts.ResumeUploadID = ""
+			uploadID, partsInfo, err = c.resumeUploadID(ctx, bucketName, objectName, opts)
+		}
+	} else {
+		// Initiate a new multipart upload.
+		uploadID, err = c.newUploadID(ctx, bucketName, objectName, opts)
+	}
 	if err != nil {
 		return UploadInfo{}, err
 	}
 	delete(opts.UserMetadata, "X-Amz-Checksum-Algorithm")
 
 	// Aborts the multipart upload in progress, if the
-	// function returns any error, since we do not resume
-	// we should purge the parts which have been uploaded
-	// to relinquish storage space.
+	// function returns any error, unless we were asked to
+	// resume we should purge the parts which have been
+	// uploaded to relinquish storage space.
 	defer func() {
-		if err != nil {
+		if err != nil && !resume {
 			c.abortMultipartUpload(ctx, bucketName, objectName, uploadID)
 		}
 	}()
 
+	if sessionPath != "" && session.UploadID != uploadID {
+		session.UploadID, session.Parts = uploadID, nil
+		if err = session.save(sessionPath); err != nil {
+			return UploadInfo{}, err
+		}
+	}
+
 	// Total data read and written to server. should be equal to 'size' at the end of the call.
 	var totalUploadedSize int64
 
@@ -149,6 +189,11 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 	// Used for readability, lastPartNumber is always totalPartsCount.
 	lastPartNumber := totalPartsCount
 
+	// Upload workers, waited for before the upload is aborted upon any
+	// failure so that no part upload is in flight anymore.
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
 	partitionCtx, partitionCancel := context.WithCancel(ctx)
 	defer partitionCancel()
 	// Send each part number to the channel to be processed.
@@ -164,14 +209,32 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 		}
 	}()
 
+	// Parts recorded before the upload started, session is updated
+	// as parts are uploaded.
+	recorded := session
+
 	// Receive each part number from the channel allowing three parallel uploads.
 	for w := 1; w <= opts.getNumThreads(); w++ {
+		wg.Add(1)
 		go func(partSize int64) {
+			defer wg.Done()
+
+			// Sends back the result of a part, gives up once the
+			// upload is stopped and nobody is receiving anymore.
+			sendResult := func(res uploadedPartRes) bool {
+				select {
+				case uploadedPartsCh <- res:
+					return true
+				case <-partitionCtx.Done():
+					return false
+				}
+			}
+
 			for {
 				var uploadReq uploadPartReq
 				var ok bool
 				select {
-				case <-ctx.Done():
+				case <-partitionCtx.Done():
 					return
 				case uploadReq, ok = <-uploadPartsCh:
 					if !ok {
@@ -192,6 +255,39 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 					partSize = lastPartSize
 				}
 
+				// Skip parts of a resumed upload which are
+				// already present on the server.
+				if part, ok := partsInfo[uploadReq.PartNum]; ok && (!withChecksum || part.ChecksumCRC32C != "") {
+					// Parts recorded in the session file of unchanged
+					// content need not be read to be verified.
+					uploaded := part.Size == partSize && recorded.isRecorded(part)
+					var err error
+					if !uploaded {
+						uploaded, err = c.isPartUploaded(io.NewSectionReader(reader, readOffset, partSize), part)
+					}
+					if err != nil {
+						sendResult(uploadedPartRes{
+							Error: err,
+						})
+						// Exit the goroutine.
+						return
+					}
+					if uploaded {
+						if opts.Progress != nil {
+							// Account the skipped part in the progress.
+							io.CopyN(io.Discard, opts.Progress, partSize)
+						}
+						if !sendResult(uploadedPartRes{
+							Size:    part.Size,
+							PartNum: uploadReq.PartNum,
+							Part:    part,
+						}) {
+							return
+						}
+						continue
+					}
+				}
+
 				sectionReader := newHook(io.NewSectionReader(reader, readOffset, partSize), opts.Progress)
 				trailer := make(http.Header, 1)
 				if withChecksum {
@@ -214,12 +310,13 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 					streamSha256: !opts.DisableContentSha256,
 					sha256Hex:    "",
 					trailer:      trailer,
+					bandwidth:    opts.bandwidth,
 				}
-				objPart, err := c.uploadPart(ctx, p)
+				objPart, err := c.uploadPart(partitionCtx, p)
 				if err != nil {
-					uploadedPartsCh <- uploadedPartRes{
+					sendResult(uploadedPartRes{
 						Error: err,
-					}
+					})
 					// Exit the goroutine.
 					return
 				}
@@ -228,10 +325,12 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 				uploadReq.Part = objPart
 
 				// Send successful part info through the channel.
-				uploadedPartsCh <- uploadedPartRes{
+				if !sendResult(uploadedPartRes{
 					Size:    objPart.Size,
 					PartNum: uploadReq.PartNum,
 					Part:    uploadReq.Part,
+				}) {
+					return
 				}
 			}
 		}(partSize)
@@ -258,6 +357,13 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 				ChecksumSHA1:   uploadRes.Part.ChecksumSHA1,
 				ChecksumSHA256: uploadRes.Part.ChecksumSHA256,
 			})
+			if sessionPath != "" {
+				// Record the part so that it is not uploaded again.
+				session.Parts = complMultipartUpload.Parts
+				if err = session.save(sessionPath); err != nil {
+					return UploadInfo{}, err
+				}
+			}
 		}
 	}
 
@@ -288,6 +394,10 @@ func (c *Client) putObjectMultipartStreamFromReadAt(ctx context.Context, bucketN
 	if err != nil {
 		return UploadInfo{}, err
 	}
+	if sessionPath != "" {
+		// The upload is complete and cannot be resumed anymore.
+		os.Remove(sessionPath)
+	}
 
 	uploadInfo.Size = totalUploadedSize
 	return uploadInfo, nil
@@ -388,7 +498,7 @@ func (c *Client) putObjectMultipartStreamOptionalChecksum(ctx context.Context, b
 		// Update progress reader appropriately to the latest offset
 		// as we read from the source.
 		hooked := newHook(bytes.NewReader(buf[:length]), opts.Progress)
-		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: hooked, partNumber: partNumber, md5Base64: md5Base64, size: partSize, sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
+		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: hooked, partNumber: partNumber, md5Base64: md5Base64, size: partSize, sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader, bandwidth: opts.bandwidth}
 		objPart, uerr := c.uploadPart(ctx, p)
 		if uerr != nil {
 			return UploadInfo{}, uerr
@@ -586,6 +696,7 @@ func (c *Client) putObjectMultipartStreamParallel(ctx context.Context, bucketNam
 				sse:          opts.ServerSideEncryption,
 				streamSha256: !opts.DisableContentSha256,
 				customHeader: customHeader,
+				bandwidth:    opts.bandwidth,
 			}
 			objPart, uerr := c.uploadPart(ctx, p)
 			if uerr != nil {
@@ -764,6 +875,7 @@ func (c *Client) putObjectDo(ctx context.Context, bucketName, objectName string,
 		contentSHA256Hex: sha256Hex,
 		streamSha256:     !opts.DisableContentSha256,
 		addCrc:           addCrc,
+		bandwidth:        c.objectBandwidth(opts.bandwidth, opts.BandwidthLimit),
 	}
 	if opts.Internal.SourceVersionID != "" {
 		if opts.Internal.SourceVersionID != nullVersionID {
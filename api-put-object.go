@@ -94,7 +94,38 @@ type PutObjectOptions struct {
 	// fill them serially and upload them in parallel.
 	// This can be used for faster uploads on non-seekable or slow-to-seek input.
 	ConcurrentStreamParts bool
-	Internal              AdvancedPutOptions
+
+	// ResumeMultipart continues a previously interrupted multipart upload
+	// of the object instead of starting over. Parts already on the server
+	// whose size and ETag match the local data are not uploaded again, and
+	// the incomplete upload is kept on failure so it can be resumed later.
+	// Only applies to multipart uploads from an io.ReaderAt, such as
+	// FPutObject, and to parts stored without SSE-C or SSE-KMS.
+	ResumeMultipart bool
+	// ResumeUploadID is the upload ID to continue when ResumeMultipart is
+	// set. If empty the most recent incomplete upload of the object is used,
+	// a new upload is initiated when there is none.
+	ResumeUploadID string
+	// ResumeStateFile is the path of a file in which the upload ID and the
+	// parts uploaded so far are recorded when ResumeMultipart is set, so
+	// that the upload can be continued by a new process. The recorded
+	// upload is only used for the same bucket, object and content, FPutObject
+	// identifies the content by the size and modification time of the file.
+	// The file is removed once the upload completes.
+	ResumeStateFile string
+
+	// Identity of the content uploaded, recorded in ResumeStateFile.
+	resumeFingerprint string
+
+	// BandwidthLimit limits the rate in bytes per second of the data
+	// uploaded, shared by the parts uploaded concurrently, in place of
+	// Options.BandwidthLimit of the client. Not limited if 0.
+	BandwidthLimit int64
+
+	// Limiter of BandwidthLimit shared by the parts of the upload.
+	bandwidth *bandwidthLimiter
+
+	Internal AdvancedPutOptions
 
 	customHeaders http.Header
 }
@@ -227,6 +258,28 @@ func (opts PutObjectOptions) Header() (header http.Header) {
 	return
 }
 
+// clone returns a copy of the options whose metadata, tags and
+// headers can be modified without affecting the original.
+func (opts PutObjectOptions) clone() PutObjectOptions {
+	c := opts
+	if opts.UserMetadata != nil {
+		c.UserMetadata = make(map[string]string, len(opts.UserMetadata))
+		for k, v := range opts.UserMetadata {
+			c.UserMetadata[k] = v
+		}
+	}
+	if opts.UserTags != nil {
+		c.UserTags = make(map[string]string, len(opts.UserTags))
+		for k, v := range opts.UserTags {
+			c.UserTags[k] = v
+		}
+	}
+	if opts.customHeaders != nil {
+		c.customHeaders = opts.customHeaders.Clone()
+	}
+	return c
+}
+
 // validate() checks if the UserMetadata map has standard headers or and raises an error if so.
 func (opts PutObjectOptions) validate() (err error) {
 	for k, v := range opts.UserMetadata {
@@ -237,9 +290,24 @@ func (opts PutObjectOptions) validate() (err error) {
 			return errInvalidArgument(v + " unsupported user defined metadata value")
 		}
 	}
+	for k, v := range map[string]string{
+		"Content-Type":        opts.ContentType,
+		"Content-Encoding":    opts.ContentEncoding,
+		"Content-Disposition": opts.ContentDisposition,
+		"Content-Language":    opts.ContentLanguage,
+		"Cache-Control":       opts.CacheControl,
+		amzStorageClass:       opts.StorageClass,
+	} {
+		if !httpguts.ValidHeaderFieldValue(v) {
+			return errInvalidArgument(v + " unsupported " + k + " value")
+		}
+	}
 	if opts.Mode != "" && !opts.Mode.IsValid() {
 		return errInvalidArgument(opts.Mode.String() + " unsupported retention mode")
 	}
+	if (opts.Mode != "") != !opts.RetainUntilDate.IsZero() {
+		return errInvalidArgument("Retention mode and retain until date must be set together.")
+	}
 	if opts.LegalHold != "" && !opts.LegalHold.IsValid() {
 		return errInvalidArgument(opts.LegalHold.String() + " unsupported legal-hold status")
 	}
@@ -268,6 +336,9 @@ func (a completedParts) Less(i, j int) bool { return a[i].PartNumber < a[j].Part
 //     until input stream reaches EOF. Maximum object size that can
 //     be uploaded through this operation will be 5TiB.
 //
+//     The stream is never staged on disk, at most one part of up to
+//     PartSize bytes is buffered in memory at a time.
+//
 //     WARNING: Passing down '-1' will use memory and these cannot
 //     be reused for best outcomes for PutObject(), pass the size always.
 //
@@ -288,6 +359,8 @@ func (c *Client) PutObject(ctx context.Context, bucketName, objectName string, r
 }
 
 func (c *Client) putObjectCommon(ctx context.Context, bucketName, objectName string, reader io.Reader, size int64, opts PutObjectOptions) (info UploadInfo, err error) {
+	opts.bandwidth = c.objectBandwidth(opts.bandwidth, opts.BandwidthLimit)
+
 	// Check for largest object size allowed.
 	if size > int64(maxMultipartPutObjectSize) {
 		return UploadInfo{}, errEntityTooLarge(size, maxMultipartPutObjectSize, bucketName, objectName)
@@ -349,6 +422,16 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 		return UploadInfo{}, err
 	}
 
+	// Read the first part before initiating a multipart upload, streams
+	// ending within it are uploaded with a single PUT.
+	buf, length, rerr := readPartFull(reader, nil, partSize)
+	if rerr != nil && rerr != io.ErrUnexpectedEOF && rerr != io.EOF {
+		return UploadInfo{}, rerr
+	}
+	if rerr != nil {
+		return c.putObject(ctx, bucketName, objectName, bytes.NewReader(buf[:length]), int64(length), opts)
+	}
+
 	if !opts.SendContentMd5 {
 		if opts.UserMetadata == nil {
 			opts.UserMetadata = make(map[string]string, 1)
@@ -375,9 +458,6 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 	// Initialize parts uploaded map.
 	partsInfo := make(map[int]ObjectPart)
 
-	// Create a buffer.
-	buf := make([]byte, partSize)
-
 	// Create checksums
 	// CRC32C is ~50% faster on AMD64 @ 30GB/s
 	var crcBytes []byte
@@ -385,13 +465,15 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 	crc := crc32.New(crc32.MakeTable(crc32.Castagnoli))
 
 	for partNumber <= totalPartsCount {
-		length, rerr := readFull(reader, buf)
-		if rerr == io.EOF && partNumber > 1 {
-			break
-		}
-
-		if rerr != nil && rerr != io.ErrUnexpectedEOF && rerr != io.EOF {
-			return UploadInfo{}, rerr
+		// The first part was read already.
+		if partNumber > 1 {
+			buf, length, rerr = readPartFull(reader, buf, partSize)
+			if rerr == io.EOF {
+				break
+			}
+			if rerr != nil && rerr != io.ErrUnexpectedEOF {
+				return UploadInfo{}, rerr
+			}
 		}
 
 		var md5Base64 string
@@ -414,7 +496,7 @@ func (c *Client) putObjectMultipartStreamNoLength(ctx context.Context, bucketNam
 		rd := newHook(bytes.NewReader(buf[:length]), opts.Progress)
 
 		// Proceed to upload the part.
-		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader}
+		p := uploadPartParams{bucketName: bucketName, objectName: objectName, uploadID: uploadID, reader: rd, partNumber: partNumber, md5Base64: md5Base64, size: int64(length), sse: opts.ServerSideEncryption, streamSha256: !opts.DisableContentSha256, customHeader: customHeader, bandwidth: opts.bandwidth}
 		objPart, uerr := c.uploadPart(ctx, p)
 		if uerr != nil {
 			return UploadInfo{}, uerr
@@ -17,11 +17,26 @@
 package minio
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/minio/minio-go/v7/pkg/encrypt"
 )
@@ -66,6 +81,29 @@ func TestPutObjectOptionsValidate(t *testing.T) {
 			t.Errorf("Test %d - output did not match with reference results, %s", i+1, err)
 		}
 	}
+
+	headerCases := []struct {
+		opts       PutObjectOptions
+		shouldPass bool
+	}{
+		{PutObjectOptions{ContentType: "text/plain", CacheControl: "max-age=3600", StorageClass: "REDUCED_REDUNDANCY"}, true},
+		{PutObjectOptions{ContentDisposition: "attachment; filename=\"a.txt\"", ContentEncoding: "gzip", ContentLanguage: "en"}, true},
+		{PutObjectOptions{ContentType: "text/plain\r\nX-Injected: 1"}, false},
+		{PutObjectOptions{CacheControl: "no-cache\n"}, false},
+		{PutObjectOptions{StorageClass: "STANDARD\x00"}, false},
+		{PutObjectOptions{Mode: Governance, RetainUntilDate: time.Now().Add(time.Hour)}, true},
+		{PutObjectOptions{Mode: Governance}, false},
+		{PutObjectOptions{RetainUntilDate: time.Now().Add(time.Hour)}, false},
+	}
+	for i, testCase := range headerCases {
+		err := testCase.opts.validate()
+		if testCase.shouldPass && err != nil {
+			t.Errorf("Header test %d - expected to pass, failed with %s", i+1, err)
+		}
+		if !testCase.shouldPass && err == nil {
+			t.Errorf("Header test %d - expected to fail, but passed", i+1)
+		}
+	}
 }
 
 type InterceptRouteTripper struct {
@@ -166,3 +204,379 @@ func Test_SSEHeaders(t *testing.T) {
 		})
 	}
 }
+
+func TestPutObjectResumeMultipart(t *testing.T) {
+	const partSize = absMinPartSize
+	data := make([]byte, 2*partSize+1024)
+	rand.Read(data)
+
+	etag := func(b []byte) string {
+		sum := md5.Sum(b)
+		return hex.EncodeToString(sum[:])
+	}
+
+	var (
+		mu            sync.Mutex
+		uploadedParts []int
+		completed     completeMultipartUpload
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case r.Method == http.MethodGet && q.Has("uploads"):
+			// One incomplete upload of the object.
+			w.Write([]byte(`<ListMultipartUploadsResult><Upload><Key>object</Key><UploadId>upload-1</UploadId></Upload></ListMultipartUploadsResult>`))
+		case r.Method == http.MethodGet && q.Get("uploadId") == "upload-1":
+			// Part 1 matches the local data, part 2 does not.
+			fmt.Fprintf(w, `<ListPartsResult><Part><PartNumber>1</PartNumber><ETag>"%s"</ETag><Size>%d</Size></Part><Part><PartNumber>2</PartNumber><ETag>"deadbeef"</ETag><Size>%d</Size></Part></ListPartsResult>`,
+				etag(data[:partSize]), partSize, partSize)
+		case r.Method == http.MethodPut && q.Get("uploadId") == "upload-1":
+			b, _ := io.ReadAll(r.Body)
+			partNumber, _ := strconv.Atoi(q.Get("partNumber"))
+			mu.Lock()
+			uploadedParts = append(uploadedParts, partNumber)
+			mu.Unlock()
+			w.Header().Set("ETag", "\""+etag(b)+"\"")
+		case r.Method == http.MethodPost && q.Get("uploadId") == "upload-1":
+			xmlDecoder(r.Body, &completed)
+			w.Write([]byte(`<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag-3"</ETag></CompleteMultipartUploadResult>`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = clnt.PutObject(context.Background(), "bucket", "object", bytes.NewReader(data), int64(len(data)), PutObjectOptions{
+		PartSize:             partSize,
+		ResumeMultipart:      true,
+		DisableContentSha256: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Ints(uploadedParts)
+	if !reflect.DeepEqual(uploadedParts, []int{2, 3}) {
+		t.Fatalf("Expected parts [2 3] to be uploaded, got %v", uploadedParts)
+	}
+	if len(completed.Parts) != 3 {
+		t.Fatalf("Expected 3 completed parts, got %d", len(completed.Parts))
+	}
+	for i, part := range completed.Parts {
+		start := int64(i) * partSize
+		end := start + partSize
+		if end > int64(len(data)) {
+			end = int64(len(data))
+		}
+		if part.PartNumber != i+1 || trimEtag(part.ETag) != etag(data[start:end]) {
+			t.Fatalf("Unexpected completed part %d: %+v", i+1, part)
+		}
+	}
+}
+
+func TestFPutObjectResumeStateFile(t *testing.T) {
+	const partSize = absMinPartSize
+	data := make([]byte, 2*partSize+1024)
+	rand.Read(data)
+
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "file")
+	if err := os.WriteFile(filePath, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	stateFile := filepath.Join(dir, "file.upload")
+
+	var (
+		mu            sync.Mutex
+		failPart      = 3
+		initiated     int
+		uploadedParts = map[int]string{}
+		completed     completeMultipartUpload
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		q := r.URL.Query()
+		switch {
+		case r.Method == http.MethodGet && q.Has("uploads"):
+			// No incomplete uploads are listed, the recorded one is used.
+			w.Write([]byte(`<ListMultipartUploadsResult></ListMultipartUploadsResult>`))
+		case r.Method == http.MethodPost && q.Has("uploads"):
+			initiated++
+			w.Write([]byte(`<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`))
+		case r.Method == http.MethodGet && q.Get("uploadId") == "upload-1":
+			w.Write([]byte(`<ListPartsResult>`))
+			for partNumber, etag := range uploadedParts {
+				size := int64(partSize)
+				if partNumber == 3 {
+					size = int64(len(data)) - 2*partSize
+				}
+				fmt.Fprintf(w, `<Part><PartNumber>%d</PartNumber><ETag>"%s"</ETag><Size>%d</Size></Part>`, partNumber, etag, size)
+			}
+			w.Write([]byte(`</ListPartsResult>`))
+		case r.Method == http.MethodPut && q.Get("uploadId") == "upload-1":
+			b, _ := io.ReadAll(r.Body)
+			partNumber, _ := strconv.Atoi(q.Get("partNumber"))
+			if partNumber == failPart {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`<Error><Code>AccessDenied</Code></Error>`))
+				return
+			}
+			if _, ok := uploadedParts[partNumber]; ok {
+				t.Errorf("Part %d uploaded again", partNumber)
+			}
+			sum := md5.Sum(b)
+			uploadedParts[partNumber] = hex.EncodeToString(sum[:])
+			w.Header().Set("ETag", "\""+uploadedParts[partNumber]+"\"")
+		case r.Method == http.MethodPost && q.Get("uploadId") == "upload-1":
+			xmlDecoder(r.Body, &completed)
+			w.Write([]byte(`<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag-3"</ETag></CompleteMultipartUploadResult>`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := PutObjectOptions{
+		PartSize:             partSize,
+		NumThreads:           1,
+		ResumeMultipart:      true,
+		ResumeStateFile:      stateFile,
+		DisableContentSha256: true,
+	}
+
+	// The upload is interrupted at the last part.
+	if _, err = clnt.FPutObject(context.Background(), "bucket", "object", filePath, opts); err == nil {
+		t.Fatal("Expected the upload to fail")
+	}
+	stateBytes, err := os.ReadFile(stateFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var session putObjectSession
+	if err = json.Unmarshal(stateBytes, &session); err != nil {
+		t.Fatal(err)
+	}
+	if session.UploadID != "upload-1" || len(session.Parts) != 2 {
+		t.Fatalf("Unexpected recorded session %+v", session)
+	}
+
+	// The upload is continued with the last part.
+	mu.Lock()
+	failPart = 0
+	mu.Unlock()
+	if _, err = clnt.FPutObject(context.Background(), "bucket", "object", filePath, opts); err != nil {
+		t.Fatal(err)
+	}
+	if initiated != 1 {
+		t.Fatalf("Expected one multipart upload to be initiated, got %d", initiated)
+	}
+	if len(completed.Parts) != 3 {
+		t.Fatalf("Expected 3 completed parts, got %d", len(completed.Parts))
+	}
+	if _, err = os.Stat(stateFile); !os.IsNotExist(err) {
+		t.Fatalf("Expected the state file to be removed, got %v", err)
+	}
+}
+
+func TestPutObjectUnknownSize(t *testing.T) {
+	const partSize = absMinPartSize
+	testCases := []struct {
+		size          int
+		multipart     bool
+		uploadedParts int
+	}{
+		{0, false, 0},
+		{1024, false, 0},
+		{partSize, true, 1},
+		{partSize + 1024, true, 2},
+	}
+	for i, testCase := range testCases {
+		data := make([]byte, testCase.size)
+		rand.Read(data)
+
+		var (
+			mu            sync.Mutex
+			initiated     bool
+			uploadedParts int
+			uploaded      []byte
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			q := r.URL.Query()
+			switch {
+			case r.Method == http.MethodPost && q.Has("uploads"):
+				initiated = true
+				w.Write([]byte(`<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`))
+			case r.Method == http.MethodPut && q.Get("uploadId") == "upload-1":
+				b, _ := io.ReadAll(r.Body)
+				uploaded = append(uploaded, b...)
+				uploadedParts++
+				w.Header().Set("ETag", "\"etag\"")
+			case r.Method == http.MethodPost && q.Get("uploadId") == "upload-1":
+				w.Write([]byte(`<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><ETag>"etag-2"</ETag></CompleteMultipartUploadResult>`))
+			case r.Method == http.MethodPut:
+				uploaded, _ = io.ReadAll(r.Body)
+				w.Header().Set("ETag", "\"etag\"")
+			default:
+				t.Errorf("Test %d: unexpected request %s %s", i+1, r.Method, r.URL)
+				w.WriteHeader(http.StatusNotImplemented)
+			}
+		}))
+
+		clnt, err := New(srv.Listener.Addr().String(), &Options{
+			Region: "us-east-1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Hide the io.ReaderAt of the reader.
+		reader := struct{ io.Reader }{bytes.NewReader(data)}
+		info, err := clnt.PutObject(context.Background(), "bucket", "object", reader, -1, PutObjectOptions{
+			PartSize:             partSize,
+			DisableContentSha256: true,
+		})
+		srv.Close()
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if initiated != testCase.multipart || uploadedParts != testCase.uploadedParts {
+			t.Errorf("Test %d: expected multipart %v with %d parts, got %v with %d parts", i+1, testCase.multipart, testCase.uploadedParts, initiated, uploadedParts)
+		}
+		if info.Size != int64(len(data)) || !bytes.Equal(uploaded, data) {
+			t.Errorf("Test %d: uploaded %d bytes, expected %d", i+1, len(uploaded), len(data))
+		}
+	}
+}
+
+func TestPutObjectParallelAbortOnFailure(t *testing.T) {
+	const partSize = absMinPartSize
+	data := make([]byte, 4*partSize)
+	rand.Read(data)
+
+	var (
+		inFlight atomic.Int32
+		aborted  atomic.Bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case r.Method == http.MethodPost && q.Has("uploads"):
+			w.Write([]byte(`<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>object</Key><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`))
+		case r.Method == http.MethodPut && q.Get("uploadId") == "upload-1":
+			inFlight.Add(1)
+			defer inFlight.Add(-1)
+			if q.Get("partNumber") == "1" {
+				io.Copy(io.Discard, r.Body)
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`<Error><Code>InvalidArgument</Code><Message>bad part</Message></Error>`))
+				return
+			}
+			// Hang until the client gives up on the part.
+			io.Copy(io.Discard, r.Body)
+			<-r.Context().Done()
+		case r.Method == http.MethodDelete && q.Get("uploadId") == "upload-1":
+			aborted.Store(true)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	_, err = clnt.PutObject(ctx, "bucket", "object", bytes.NewReader(data), int64(len(data)), PutObjectOptions{
+		PartSize:             partSize,
+		NumThreads:           4,
+		DisableContentSha256: true,
+	})
+	if ToErrorResponse(err).Code != "InvalidArgument" {
+		t.Fatalf("Expected InvalidArgument error, got %v", err)
+	}
+	if !aborted.Load() {
+		t.Fatal("Expected the multipart upload to be aborted")
+	}
+	// The remaining part uploads must have been canceled.
+	deadline := time.Now().Add(5 * time.Second)
+	for inFlight.Load() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected no part uploads in flight, got %d", inFlight.Load())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestReadPartFull(t *testing.T) {
+	const partSize = 5*minPartBufferSize + 3
+	data := make([]byte, 2*partSize+10)
+	rand.Read(data)
+
+	testCases := []struct {
+		name   string
+		size   int
+		parts  []int
+		maxCap int
+	}{
+		{"empty", 0, []int{0}, minPartBufferSize},
+		{"small", 10, []int{10}, minPartBufferSize},
+		{"one part", partSize, []int{partSize, 0}, partSize},
+		{"many parts", len(data), []int{partSize, partSize, 10}, partSize},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reader := io.LimitReader(bytes.NewReader(data), int64(testCase.size))
+			var (
+				buf    []byte
+				offset int
+			)
+			for i, want := range testCase.parts {
+				var n int
+				var err error
+				buf, n, err = readPartFull(reader, buf, partSize)
+				if n != want {
+					t.Fatalf("part %d: expected %d bytes, got %d", i+1, want, n)
+				}
+				switch {
+				case n == partSize && err != nil:
+					t.Fatalf("part %d: expected no error, got %v", i+1, err)
+				case n == 0 && err != io.EOF:
+					t.Fatalf("part %d: expected %v, got %v", i+1, io.EOF, err)
+				case n > 0 && n < partSize && err != io.ErrUnexpectedEOF:
+					t.Fatalf("part %d: expected %v, got %v", i+1, io.ErrUnexpectedEOF, err)
+				}
+				if !bytes.Equal(buf[:n], data[offset:offset+n]) {
+					t.Fatalf("part %d: mismatched data", i+1)
+				}
+				offset += n
+			}
+			if cap(buf) > testCase.maxCap {
+				t.Fatalf("expected buffer capacity at most %d, got %d", testCase.maxCap, cap(buf))
+			}
+		})
+	}
+}
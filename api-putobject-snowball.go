@@ -51,7 +51,9 @@ type SnowballOptions struct {
 	Compress bool
 
 	// SkipErrs if enabled will skip any errors while reading the
-	// object content while creating the snowball archive
+	// object content while creating the snowball archive.
+	// Objects are read into memory before they are added to the
+	// archive, so that failed objects can be left out of it.
 	SkipErrs bool
 }
 
@@ -198,30 +200,36 @@ objectLoop:
 				header.PAXRecords["minio.metadata."+k] = strings.Join(vals, ",")
 			}
 
+			content := obj.Content
+			if opts.SkipErrs {
+				// A partially written entry cannot be removed from
+				// the archive, read the content before writing it.
+				buf, err := readSnowballContent(obj)
+				if err != nil {
+					closeObj()
+					continue
+				}
+				content = bytes.NewReader(buf)
+			}
+
 			if err := t.WriteHeader(&header); err != nil {
 				closeObj()
 				return err
 			}
-			n, err := io.Copy(t, obj.Content)
+			n, err := io.Copy(t, content)
 			if err != nil {
 				closeObj()
-				if opts.SkipErrs {
-					continue
-				}
 				return err
 			}
 			if n != obj.Size {
 				closeObj()
-				if opts.SkipErrs {
-					continue
-				}
 				return io.ErrUnexpectedEOF
 			}
 			closeObj()
 		}
 	}
-	// Flush tar
-	err = t.Flush()
+	// Flush tar and write the end of archive marker.
+	err = t.Close()
 	if err != nil {
 		return err
 	}
@@ -244,3 +252,19 @@ objectLoop:
 	_, err = c.PutObject(ctx, bucketName, fmt.Sprintf("snowball-upload-%x.tar", rand), rc, sz, opts.Opts)
 	return err
 }
+
+// readSnowballContent - reads exactly Size bytes of the content
+// of the object.
+func readSnowballContent(obj SnowballObject) ([]byte, error) {
+	if obj.Size < 0 {
+		return nil, errInvalidArgument(fmt.Sprintf("Invalid size %d for object %s.", obj.Size, obj.Key))
+	}
+	buf := make([]byte, obj.Size)
+	if _, err := io.ReadFull(obj.Content, buf); err != nil {
+		return nil, err
+	}
+	if n, _ := io.CopyN(io.Discard, obj.Content, 1); n > 0 {
+		return nil, tar.ErrWriteTooLong
+	}
+	return buf, nil
+}
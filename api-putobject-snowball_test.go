@@ -0,0 +1,90 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type failingReader struct {
+	io.Reader
+}
+
+func (r failingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err == io.EOF {
+		err = errors.New("read failed")
+	}
+	return n, err
+}
+
+func TestPutObjectsSnowballSkipErrs(t *testing.T) {
+	extracted := make(map[string]string)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.Header.Get("X-Amz-Meta-Snowball-Auto-Extract") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		tr := tar.NewReader(r.Body)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			content, err := io.ReadAll(tr)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			extracted[hdr.Name] = string(content)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objs := make(chan SnowballObject, 4)
+	objs <- SnowballObject{Key: "a", Size: 3, Content: strings.NewReader("aaa")}
+	objs <- SnowballObject{Key: "broken", Size: 10, Content: failingReader{strings.NewReader("bbb")}}
+	objs <- SnowballObject{Key: "long", Size: 2, Content: strings.NewReader("ccc")}
+	objs <- SnowballObject{Key: "/d", Size: 1, Content: strings.NewReader("d")}
+	close(objs)
+
+	opts := SnowballOptions{InMemory: true, SkipErrs: true}
+	opts.Opts.DisableContentSha256 = true
+	if err = c.PutObjectsSnowball(context.Background(), "bucket", opts, objs); err != nil {
+		t.Fatal(err)
+	}
+	if len(extracted) != 2 || extracted["a"] != "aaa" || extracted["d"] != "d" {
+		t.Fatalf("unexpected archive content: %v", extracted)
+	}
+}
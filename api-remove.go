@@ -405,7 +405,8 @@ func (c *Client) removeObjects(ctx context.Context, bucketName string, objectsCh
 					case "InvalidArgument", "NoSuchVersion":
 						continue
 					}
-					resultCh <- removeResult
+					removeResult.ObjectName = object.Key
+					removeResult.ObjectVersionID = object.VersionID
 				}
 
 				resultCh <- removeResult
@@ -445,11 +446,10 @@ func (c *Client) removeObjects(ctx context.Context, bucketName string, objectsCh
 			contentSHA256Hex: sum256Hex(removeBytes),
 			customHeader:     headers,
 		})
-		if resp != nil {
-			if resp.StatusCode != http.StatusOK {
-				e := httpRespToErrorResponse(resp, bucketName, "")
-				resultCh <- RemoveObjectResult{ObjectName: "", Err: e}
-			}
+		if err == nil && resp.StatusCode != http.StatusOK {
+			// The whole batch failed, report it for every object.
+			err = httpRespToErrorResponse(resp, bucketName, "")
+			closeResponse(resp)
 		}
 		if err != nil {
 			for _, b := range batch {
@@ -485,9 +485,10 @@ func (c *Client) RemoveIncompleteUpload(ctx context.Context, bucketName, objectN
 	}
 
 	for _, uploadID := range uploadIDs {
-		// abort incomplete multipart upload, based on the upload id passed.
+		// abort incomplete multipart upload, based on the upload id passed,
+		// an upload which completed or was aborted meanwhile is gone already.
 		err := c.abortMultipartUpload(ctx, bucketName, objectName, uploadID)
-		if err != nil {
+		if err != nil && ToErrorResponse(err).Code != "NoSuchUpload" {
 			return err
 		}
 	}
@@ -0,0 +1,101 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+)
+
+// Tests that failures of RemoveObjects are reported once per object.
+func TestRemoveObjectsErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusForbidden)
+		if r.Method == http.MethodPost {
+			io.WriteString(w, `<Error><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := []string{"a", "b", "c", "invalid\x01"}
+	objectsCh := make(chan ObjectInfo, len(keys))
+	for _, key := range keys {
+		objectsCh <- ObjectInfo{Key: key}
+	}
+	close(objectsCh)
+
+	var failed []string
+	for rErr := range c.RemoveObjects(context.Background(), "bucket", objectsCh, RemoveObjectsOptions{}) {
+		if code := ToErrorResponse(rErr.Err).Code; code != "AccessDenied" {
+			t.Errorf("%s: expected AccessDenied, got %v", rErr.ObjectName, rErr.Err)
+		}
+		failed = append(failed, rErr.ObjectName)
+	}
+	sort.Strings(failed)
+	if len(failed) != len(keys) {
+		t.Fatalf("expected %d failures, got %v", len(keys), failed)
+	}
+	for i := range keys {
+		if failed[i] != keys[i] {
+			t.Errorf("expected failure for %q, got %q", keys[i], failed[i])
+		}
+	}
+}
+
+// Tests that uploads which are gone already do not fail RemoveIncompleteUpload.
+func TestRemoveIncompleteUpload(t *testing.T) {
+	var aborted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			io.WriteString(w, `<ListMultipartUploadsResult><Bucket>bucket</Bucket><IsTruncated>false</IsTruncated>`+
+				`<Upload><Key>object</Key><UploadId>1</UploadId></Upload>`+
+				`<Upload><Key>object</Key><UploadId>2</UploadId></Upload></ListMultipartUploadsResult>`)
+		case http.MethodDelete:
+			uploadID := r.URL.Query().Get("uploadId")
+			aborted = append(aborted, uploadID)
+			if uploadID == "1" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.RemoveIncompleteUpload(context.Background(), "bucket", "object"); err != nil {
+		t.Fatal(err)
+	}
+	if len(aborted) != 2 {
+		t.Fatalf("expected both uploads to be aborted, aborted %v", aborted)
+	}
+}
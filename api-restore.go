@@ -149,6 +149,9 @@ func (c *Client) RestoreObject(ctx context.Context, bucketName, objectName, vers
 	if err := s3utils.CheckValidObjectName(objectName); err != nil {
 		return err
 	}
+	if req.Days != nil && *req.Days < 1 {
+		return errInvalidArgument("Number of days to keep the restored copy must be at least 1.")
+	}
 
 	restoreRequestBytes, err := xml.Marshal(req)
 	if err != nil {
@@ -176,7 +179,7 @@ func (c *Client) RestoreObject(ctx context.Context, bucketName, objectName, vers
 		return err
 	}
 	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
-		return httpRespToErrorResponse(resp, bucketName, "")
+		return httpRespToErrorResponse(resp, bucketName, objectName)
 	}
 	return nil
 }
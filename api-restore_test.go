@@ -0,0 +1,79 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRestoreObject(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			if _, ok := r.URL.Query()["restore"]; !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			w.WriteHeader(http.StatusAccepted)
+		case http.MethodHead:
+			w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+			w.Header().Set("ETag", `"etag"`)
+			w.Header().Set("x-amz-restore", `ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	req := RestoreRequest{}
+	req.SetDays(2)
+	req.SetGlacierJobParameters(GlacierJobParameters{Tier: TierBulk})
+	if err = c.RestoreObject(ctx, "bucket", "object", "", req); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, "<Days>2</Days>") || !strings.Contains(body, "<Tier>Bulk</Tier>") {
+		t.Fatalf("unexpected restore request %s", body)
+	}
+
+	req.SetDays(0)
+	if err = c.RestoreObject(ctx, "bucket", "object", "", req); err == nil {
+		t.Fatal("expected restore with zero days to fail")
+	}
+
+	info, err := c.StatObject(ctx, "bucket", "object", StatObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiry := time.Date(2012, time.December, 21, 0, 0, 0, 0, time.UTC)
+	if info.Restore == nil || info.Restore.OngoingRestore || !info.Restore.ExpiryTime.Equal(expiry) {
+		t.Fatalf("unexpected restore info %+v", info.Restore)
+	}
+}
@@ -529,6 +529,11 @@ func (s *SelectResults) start(pipeWriter *io.PipeWriter) {
 			// Extract the prelude(12 bytes) into a struct to extract relevant information.
 			prelude, err = processPrelude(crcReader, crc)
 			if err != nil {
+				// The stream always ends with an End event, results
+				// are truncated if the response ends before it.
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
 				pipeWriter.CloseWithError(err)
 				closeResponse(s.resp)
 				return
@@ -546,6 +551,7 @@ func (s *SelectResults) start(pipeWriter *io.PipeWriter) {
 			// Get the actual payload length so that the appropriate amount of
 			// bytes can be read or parsed.
 			payloadLen := prelude.PayloadLen()
+			payload := io.LimitReader(crcReader, payloadLen)
 
 			m := messageType(headers.Get("message-type"))
 
@@ -568,7 +574,7 @@ func (s *SelectResults) start(pipeWriter *io.PipeWriter) {
 					closeResponse(s.resp)
 					return
 				case recordsEvent:
-					if _, err = io.Copy(pipeWriter, io.LimitReader(crcReader, payloadLen)); err != nil {
+					if _, err = io.Copy(pipeWriter, payload); err != nil {
 						pipeWriter.CloseWithError(err)
 						closeResponse(s.resp)
 						return
@@ -576,7 +582,7 @@ func (s *SelectResults) start(pipeWriter *io.PipeWriter) {
 				case progressEvent:
 					switch c {
 					case xmlContent:
-						if err = xmlDecoder(io.LimitReader(crcReader, payloadLen), s.progress); err != nil {
+						if err = xmlDecoder(payload, s.progress); err != nil {
 							pipeWriter.CloseWithError(err)
 							closeResponse(s.resp)
 							return
@@ -589,7 +595,7 @@ func (s *SelectResults) start(pipeWriter *io.PipeWriter) {
 				case statsEvent:
 					switch c {
 					case xmlContent:
-						if err = xmlDecoder(io.LimitReader(crcReader, payloadLen), s.stats); err != nil {
+						if err = xmlDecoder(payload, s.stats); err != nil {
 							pipeWriter.CloseWithError(err)
 							closeResponse(s.resp)
 							return
@@ -602,6 +608,14 @@ func (s *SelectResults) start(pipeWriter *io.PipeWriter) {
 				}
 			}
 
+			// Skip the payload of events which are not handled, such as
+			// the Cont events sent to keep the connection alive.
+			if _, err = io.Copy(io.Discard, payload); err != nil {
+				pipeWriter.CloseWithError(err)
+				closeResponse(s.resp)
+				return
+			}
+
 			// Ensures that the full message's CRC is correct and
 			// that the message is not corrupted
 			if err := checkCRC(s.resp.Body, crc.Sum32()); err != nil {
@@ -706,7 +720,7 @@ func extractHeaderValue(body io.Reader) (string, error) {
 // extracts a string from byte array of a particular number of bytes.
 func extractString(source io.Reader, lenBytes int) (string, error) {
 	myVal := make([]byte, lenBytes)
-	_, err := source.Read(myVal)
+	_, err := readFull(source, myVal)
 	if err != nil {
 		return "", err
 	}
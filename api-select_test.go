@@ -0,0 +1,100 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"testing"
+	"testing/iotest"
+)
+
+// selectEvent encodes an event stream message with the given headers
+// and payload as sent by the Select API.
+func selectEvent(headers [][2]string, payload string) []byte {
+	var hdr bytes.Buffer
+	for _, h := range headers {
+		hdr.WriteByte(byte(len(h[0]) + 1))
+		hdr.WriteString(":" + h[0])
+		hdr.WriteByte(7)
+		binary.Write(&hdr, binary.BigEndian, uint16(len(h[1])))
+		hdr.WriteString(h[1])
+	}
+	var msg bytes.Buffer
+	binary.Write(&msg, binary.BigEndian, uint32(16+hdr.Len()+len(payload)))
+	binary.Write(&msg, binary.BigEndian, uint32(hdr.Len()))
+	binary.Write(&msg, binary.BigEndian, crc32.ChecksumIEEE(msg.Bytes()))
+	msg.Write(hdr.Bytes())
+	msg.WriteString(payload)
+	binary.Write(&msg, binary.BigEndian, crc32.ChecksumIEEE(msg.Bytes()))
+	return msg.Bytes()
+}
+
+func eventHeaders(event, content string) [][2]string {
+	headers := [][2]string{{"message-type", "event"}, {"event-type", event}}
+	if content != "" {
+		headers = append(headers, [2]string{"content-type", content})
+	}
+	return headers
+}
+
+func TestSelectResults(t *testing.T) {
+	var stream bytes.Buffer
+	stream.Write(selectEvent(eventHeaders("Records", "application/octet-stream"), "a,1\n"))
+	stream.Write(selectEvent(eventHeaders("Cont", ""), "keep-alive"))
+	stream.Write(selectEvent(eventHeaders("Records", "application/octet-stream"), "b,2\n"))
+	stream.Write(selectEvent(eventHeaders("Stats", "text/xml"),
+		"<Stats><BytesScanned>10</BytesScanned><BytesProcessed>10</BytesProcessed><BytesReturned>8</BytesReturned></Stats>"))
+	full := stream.Bytes()
+	end := selectEvent(eventHeaders("End", ""), "")
+
+	newResults := func(body []byte) *SelectResults {
+		res, err := NewSelectResults(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(iotest.OneByteReader(bytes.NewReader(body))),
+		}, "bucket")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	res := newResults(append(append([]byte{}, full...), end...))
+	data, err := io.ReadAll(res)
+	res.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "a,1\nb,2\n" {
+		t.Fatalf("unexpected records %q", data)
+	}
+	if stats := res.Stats(); stats.BytesScanned != 10 || stats.BytesReturned != 8 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+
+	// Results without the End event are truncated.
+	res = newResults(full)
+	_, err = io.ReadAll(res)
+	res.Close()
+	if err != io.ErrUnexpectedEOF {
+		t.Fatalf("expected %v for truncated results, got %v", io.ErrUnexpectedEOF, err)
+	}
+}
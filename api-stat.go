@@ -44,14 +44,12 @@ func (c *Client) BucketExists(ctx context.Context, bucketName string) (bool, err
 		}
 		return false, err
 	}
-	if resp != nil {
+	if resp != nil && resp.StatusCode != http.StatusOK {
 		resperr := httpRespToErrorResponse(resp, bucketName, "")
 		if ToErrorResponse(resperr).Code == "NoSuchBucket" {
 			return false, nil
 		}
-		if resp.StatusCode != http.StatusOK {
-			return false, httpRespToErrorResponse(resp, bucketName, "")
-		}
+		return false, resperr
 	}
 	return true, nil
 }
@@ -41,6 +41,7 @@ import (
 
 	md5simd "github.com/minio/md5-simd"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 	"github.com/minio/minio-go/v7/pkg/signer"
 	"golang.org/x/net/publicsuffix"
@@ -100,6 +101,14 @@ type Client struct {
 	healthStatus int32
 
 	trailingHeaderSupport bool
+	maxRetries            int
+	maxRetryAfter         time.Duration
+
+	requestHook  func(req *http.Request) error
+	customSigner RequestSigner
+
+	// Limits the rate of object uploads and downloads.
+	bandwidth *bandwidthLimiter
 }
 
 // Options for New method
@@ -124,6 +133,43 @@ type Options struct {
 	// Custom hash routines. Leave nil to use standard.
 	CustomMD5    func() md5simd.Hasher
 	CustomSHA256 func() md5simd.Hasher
+
+	// Number of times a request is attempted. Defaults to MaxRetry
+	// if this option is not configured. Set to 1 to disable retries.
+	MaxRetries int
+
+	// Longest wait for a server supplied Retry-After before a retry.
+	// Defaults to MaxRetryAfter if this option is not configured.
+	MaxRetryAfter time.Duration
+
+	// SignatureType forces signature V2 or V4 for all requests,
+	// including presigned ones. When not set the signature is
+	// determined by the credentials and the endpoint.
+	SignatureType credentials.SignatureType
+
+	// BandwidthLimit limits the rate in bytes per second of the data
+	// of all object uploads and downloads of the client, shared by
+	// concurrent transfers. Not limited if 0.
+	BandwidthLimit int64
+
+	// RequestHook is called with every outgoing request, including
+	// retries, before it is signed. It can be used to add headers
+	// required by the endpoint. Not called for presigned URLs.
+	RequestHook func(req *http.Request) error
+
+	// CustomSigner signs the outgoing requests in place of the
+	// built-in signature V2 and V4 implementations. Presigned URLs
+	// are still generated with the built-in signatures.
+	CustomSigner RequestSigner
+}
+
+// RequestSigner signs requests with an alternative signature scheme.
+type RequestSigner interface {
+	// SignRequest signs the request with the credentials of the client,
+	// location is the region of the bucket. The request has its headers
+	// and body set, X-Amz-Content-Sha256 is set to the payload hash or
+	// UNSIGNED-PAYLOAD. The returned request is sent.
+	SignRequest(req *http.Request, creds credentials.Value, location string) (*http.Request, error)
 }
 
 // Global constants.
@@ -161,8 +207,10 @@ func New(endpoint string, opts *Options) (*Client, error) {
 		return nil, err
 	}
 	if s3utils.IsAmazonEndpoint(*clnt.endpointURL) {
-		// If Amazon S3 set to signature v4.
-		clnt.overrideSignerType = credentials.SignatureV4
+		// If Amazon S3 set to signature v4, unless forced otherwise.
+		if opts.SignatureType == credentials.SignatureDefault {
+			clnt.overrideSignerType = credentials.SignatureV4
+		}
 		// Amazon S3 endpoints are resolved into dual-stack endpoints by default
 		// for backwards compatibility.
 		clnt.s3DualstackEnabled = true
@@ -206,6 +254,12 @@ func privateNew(endpoint string, opts *Options) (*Client, error) {
 		return nil, err
 	}
 
+	switch opts.SignatureType {
+	case credentials.SignatureDefault, credentials.SignatureV2, credentials.SignatureV4:
+	default:
+		return nil, errInvalidArgument("Signature type can only be forced to SignatureV2 or SignatureV4.")
+	}
+
 	// Initialize cookies to preserve server sent cookies if any and replay
 	// them upon each request.
 	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
@@ -216,8 +270,11 @@ func privateNew(endpoint string, opts *Options) (*Client, error) {
 	// instantiate new Client.
 	clnt := new(Client)
 
-	// Save the credentials.
+	// Save the credentials, requests are sent unsigned without them.
 	clnt.credsProvider = opts.Creds
+	if clnt.credsProvider == nil {
+		clnt.credsProvider = credentials.NewStaticV4("", "", "")
+	}
 
 	// Remember whether we are using https or not
 	clnt.secure = opts.Secure
@@ -270,7 +327,17 @@ func privateNew(endpoint string, opts *Options) (*Client, error) {
 		clnt.sha256Hasher = newSHA256Hasher
 	}
 
-	clnt.trailingHeaderSupport = opts.TrailingHeaders && clnt.overrideSignerType.IsV4()
+	// Signature type forced by the caller.
+	clnt.overrideSignerType = opts.SignatureType
+
+	// Trailing headers are signed with signature V4 only.
+	clnt.trailingHeaderSupport = opts.TrailingHeaders && clnt.overrideSignerType.IsV4() && opts.CustomSigner == nil
+	clnt.maxRetries = opts.MaxRetries
+	clnt.maxRetryAfter = opts.MaxRetryAfter
+
+	clnt.requestHook = opts.RequestHook
+	clnt.customSigner = opts.CustomSigner
+	clnt.bandwidth = newBandwidthLimiter(opts.BandwidthLimit)
 
 	// Sets bucket lookup style, whether server accepts DNS or Path lookup. Default is Auto - determined
 	// by the SDK. When Auto is specified, DNS lookup is used for Amazon/Google cloud endpoints and Path for all other endpoints.
@@ -409,17 +476,9 @@ func (c *Client) HealthCheck(hcDuration time.Duration) (context.CancelFunc, erro
 	probeBucketName := randString(60, rand.NewSource(time.Now().UnixNano()), "probe-health-")
 	ctx, cancelFn := context.WithCancel(context.Background())
 	atomic.StoreInt32(&c.healthStatus, offline)
-	{
-		// Change to online, if we can connect.
-		gctx, gcancel := context.WithTimeout(ctx, 3*time.Second)
-		_, err := c.getBucketLocation(gctx, probeBucketName)
-		gcancel()
-		if !IsNetworkOrHostDown(err, false) {
-			switch ToErrorResponse(err).Code {
-			case "NoSuchBucket", "AccessDenied", "":
-				atomic.CompareAndSwapInt32(&c.healthStatus, offline, online)
-			}
-		}
+	// Change to online, if we can connect.
+	if c.probeHealth(ctx, probeBucketName) {
+		atomic.CompareAndSwapInt32(&c.healthStatus, offline, online)
 	}
 
 	go func(duration time.Duration) {
@@ -432,16 +491,8 @@ func (c *Client) HealthCheck(hcDuration time.Duration) (context.CancelFunc, erro
 				return
 			case <-timer.C:
 				// Do health check the first time and ONLY if the connection is marked offline
-				if c.IsOffline() {
-					gctx, gcancel := context.WithTimeout(context.Background(), 3*time.Second)
-					_, err := c.getBucketLocation(gctx, probeBucketName)
-					gcancel()
-					if !IsNetworkOrHostDown(err, false) {
-						switch ToErrorResponse(err).Code {
-						case "NoSuchBucket", "AccessDenied", "":
-							atomic.CompareAndSwapInt32(&c.healthStatus, offline, online)
-						}
-					}
+				if c.IsOffline() && c.probeHealth(ctx, probeBucketName) {
+					atomic.CompareAndSwapInt32(&c.healthStatus, offline, online)
 				}
 
 				timer.Reset(duration)
@@ -451,6 +502,33 @@ func (c *Client) HealthCheck(hcDuration time.Duration) (context.CancelFunc, erro
 	return cancelFn, nil
 }
 
+// probeHealth - returns true if the endpoint responds to a bucket
+// location request of the probe bucket. The request is always sent,
+// unlike getBucketLocation which may answer from the configured region
+// or the bucket location cache.
+func (c *Client) probeHealth(ctx context.Context, probeBucketName string) bool {
+	gctx, gcancel := context.WithTimeout(ctx, 3*time.Second)
+	defer gcancel()
+
+	req, err := c.getBucketLocationRequest(gctx, probeBucketName)
+	if err != nil {
+		return false
+	}
+	resp, err := c.do(req)
+	defer closeResponse(resp)
+	if err == nil {
+		_, err = processBucketLocationResponse(resp, probeBucketName)
+	}
+	if IsNetworkOrHostDown(err, false) {
+		return false
+	}
+	switch ToErrorResponse(err).Code {
+	case "NoSuchBucket", "AccessDenied", "":
+		return true
+	}
+	return false
+}
+
 // requestMetadata - is container for all the values to make a request.
 type requestMetadata struct {
 	// If set newRequest presigns the URL.
@@ -472,7 +550,16 @@ type requestMetadata struct {
 	contentSHA256Hex string // carries hex encoded sha256sum
 	streamSha256     bool
 	addCrc           bool
-	trailer          http.Header // (http.Request).Trailer. Requires v4 signature.
+	trailer          http.Header       // (http.Request).Trailer. Requires v4 signature.
+	bandwidth        *bandwidthLimiter // limits the rate of the request and response body.
+}
+
+// redactedHeaders - request headers with secrets, which are redacted
+// from the HTTP trace.
+var redactedHeaders = []string{
+	"X-Amz-Security-Token",
+	encrypt.SseCustomerKey,
+	encrypt.SseCopyCustomerKey,
 }
 
 // dumpHTTP - dump HTTP request and response.
@@ -483,12 +570,23 @@ func (c *Client) dumpHTTP(req *http.Request, resp *http.Response) error {
 		return err
 	}
 
+	// Dump a copy of the request, so that secrets are only redacted
+	// from the trace.
+	req = req.Clone(req.Context())
+
 	// Filter out Signature field from Authorization header.
 	origAuth := req.Header.Get("Authorization")
 	if origAuth != "" {
 		req.Header.Set("Authorization", redactSignature(origAuth))
 	}
 
+	// Filter out session tokens and SSE-C keys.
+	for _, header := range redactedHeaders {
+		if req.Header.Get(header) != "" {
+			req.Header.Set(header, "**REDACTED**")
+		}
+	}
+
 	// Only display request header.
 	reqTrace, err := httputil.DumpRequestOut(req, false)
 	if err != nil {
@@ -593,7 +691,14 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 
 	var retryable bool       // Indicates if request can be retried.
 	var bodySeeker io.Seeker // Extracted seeker from io.Reader.
-	reqRetry := MaxRetry     // Indicates how many times we can retry the request
+	reqRetry := c.maxRetries // Indicates how many times we can retry the request
+	if reqRetry <= 0 {
+		reqRetry = MaxRetry
+	}
+	maxRetryAfter := c.maxRetryAfter
+	if maxRetryAfter <= 0 {
+		maxRetryAfter = MaxRetryAfter
+	}
 
 	if metadata.contentBody != nil {
 		// Check if body is seekable then it is retryable.
@@ -634,7 +739,7 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 	// Indicate to our routine to exit cleanly upon return.
 	defer cancel()
 
-	for range c.newRetryTimer(retryCtx, reqRetry, DefaultRetryUnit, DefaultRetryCap, MaxJitter) {
+	for attempt := range c.newRetryTimer(retryCtx, reqRetry, DefaultRetryUnit, DefaultRetryCap, MaxJitter) {
 		// Retry executes the following function body if request has an
 		// error until maxRetries have been exhausted, retry attempts are
 		// performed after waiting for a given period of time in a
@@ -672,6 +777,12 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 		// For any known successful http status, return quickly.
 		for _, httpStatus := range successStatus {
 			if httpStatus == res.StatusCode {
+				if metadata.bandwidth != nil {
+					res.Body = struct {
+						io.Reader
+						io.Closer
+					}{newBandwidthReader(ctx, res.Body, metadata.bandwidth), res.Body}
+				}
 				return res, nil
 			}
 		}
@@ -702,11 +813,19 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 		// Additionally, we should only retry if bucketLocation and custom
 		// region is empty.
 		if c.region == "" {
-			switch errResponse.Code {
+			code := errResponse.Code
+			if method == http.MethodHead && (res.StatusCode == http.StatusMovedPermanently || res.StatusCode == http.StatusBadRequest) {
+				// Responses to HEAD requests have no body, the region
+				// is only told by the x-amz-bucket-region header.
+				code = "PermanentRedirect"
+			}
+			switch code {
 			case "AuthorizationHeaderMalformed":
 				fallthrough
 			case "InvalidRegion":
 				fallthrough
+			case "PermanentRedirect":
+				fallthrough
 			case "AccessDenied":
 				if errResponse.Region == "" {
 					// Region is empty we simply return the error.
@@ -715,9 +834,21 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 				// Region is not empty figure out a way to
 				// handle this appropriately.
 				if metadata.bucketName != "" {
-					// Gather Cached location only if bucketName is present.
-					if location, cachedOk := c.bucketLocCache.Get(metadata.bucketName); cachedOk && location != errResponse.Region {
+					// Gather Cached location only if bucketName is present,
+					// retry only if the request was signed with another
+					// location, as chosen by newRequest.
+					location := metadata.bucketLocation
+					if location == "" {
+						location, _ = c.bucketLocCache.Get(metadata.bucketName)
+					}
+					if location == "" {
+						location = getDefaultLocation(*c.endpointURL, c.region)
+					}
+					if location != errResponse.Region {
 						c.bucketLocCache.Set(metadata.bucketName, errResponse.Region)
+						if metadata.bucketLocation != "" {
+							metadata.bucketLocation = errResponse.Region
+						}
 						continue // Retry.
 					}
 				} else {
@@ -732,13 +863,16 @@ func (c *Client) executeMethod(ctx context.Context, method string, metadata requ
 			}
 		}
 
-		// Verify if error response code is retryable.
-		if isS3CodeRetryable(errResponse.Code) {
-			continue // Retry.
-		}
-
-		// Verify if http status code is retryable.
-		if isHTTPStatusRetryable(res.StatusCode) {
+		// Verify if error response code or http status code is retryable.
+		if isS3CodeRetryable(errResponse.Code) || isHTTPStatusRetryable(res.StatusCode) {
+			// Wait for as long as the server asked for, if there are
+			// attempts left.
+			if delay := retryAfter(res, maxRetryAfter); delay > 0 && attempt < reqRetry {
+				select {
+				case <-time.After(delay):
+				case <-retryCtx.Done():
+				}
+			}
 			continue // Retry.
 		}
 
@@ -777,9 +911,9 @@ func (c *Client) newRequest(ctx context.Context, method string, metadata request
 
 	// Look if target url supports virtual host.
 	// We explicitly disallow MakeBucket calls to not use virtual DNS style,
-	// since the resolution may fail.
+	// since the resolution may fail, unless virtual DNS style is forced.
 	isMakeBucket := (metadata.objectName == "" && method == http.MethodPut && len(metadata.queryValues) == 0)
-	isVirtualHost := c.isVirtualHostStyleRequest(*c.endpointURL, metadata.bucketName) && !isMakeBucket
+	isVirtualHost := c.isVirtualHostStyleRequest(*c.endpointURL, metadata.bucketName) && !(isMakeBucket && c.lookup != BucketLookupDNS)
 
 	// Construct a new target URL.
 	targetURL, err := c.makeTargetURL(metadata.bucketName, metadata.objectName, location,
@@ -859,6 +993,8 @@ func (c *Client) newRequest(ctx context.Context, method string, metadata request
 	// by making sure to wrap the closer as a nop.
 	if metadata.contentLength == 0 {
 		req.Body = nil
+	} else if metadata.bandwidth != nil {
+		req.Body = io.NopCloser(newBandwidthReader(ctx, metadata.contentBody, metadata.bandwidth))
 	} else {
 		req.Body = io.NopCloser(metadata.contentBody)
 	}
@@ -875,6 +1011,25 @@ func (c *Client) newRequest(ctx context.Context, method string, metadata request
 		req.Header.Set("Content-Md5", metadata.contentMD5Base64)
 	}
 
+	if c.requestHook != nil {
+		if err = c.requestHook(req); err != nil {
+			return nil, err
+		}
+	}
+
+	// Custom signer set then it signs in place of the built-in signatures.
+	if c.customSigner != nil {
+		if len(metadata.trailer) > 0 {
+			return nil, errInvalidArgument("Trailing headers are not supported with a custom signer.")
+		}
+		shaHeader := unsignedPayload
+		if metadata.contentSHA256Hex != "" {
+			shaHeader = metadata.contentSHA256Hex
+		}
+		req.Header.Set("X-Amz-Content-Sha256", shaHeader)
+		return c.customSigner.SignRequest(req, value, location)
+	}
+
 	// For anonymous requests just return.
 	if signerType.IsAnonymous() {
 		return req, nil
@@ -938,6 +1093,11 @@ func (c *Client) makeTargetURL(bucketName, objectName, bucketLocation string, is
 			// For more details about enabling transfer acceleration read here.
 			// http://docs.aws.amazon.com/AmazonS3/latest/dev/transfer-acceleration.html
 			host = c.s3AccelerateEndpoint
+			if c.s3DualstackEnabled && host == "s3-accelerate.amazonaws.com" {
+				// Accelerated requests over IPv6 use the dual-stack
+				// accelerate endpoint.
+				host = "s3-accelerate.dualstack.amazonaws.com"
+			}
 		} else {
 			// Do not change the host if the endpoint URL is a FIPS S3 endpoint or a S3 PrivateLink interface endpoint
 			if !s3utils.IsAmazonFIPSEndpoint(*c.endpointURL) && !s3utils.IsAmazonPrivateLinkEndpoint(*c.endpointURL) {
@@ -18,10 +18,19 @@
 package minio
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/minio/minio-go/v7/pkg/policy"
 )
 
@@ -85,6 +94,76 @@ func TestSignatureType(t *testing.T) {
 	}
 }
 
+// Tests forcing the signature type of a client.
+func TestSignatureTypeOption(t *testing.T) {
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+
+	testCases := []struct {
+		creds         *credentials.Credentials
+		signatureType credentials.SignatureType
+		authPrefix    string
+		presignParam  string
+		postParam     string
+	}{
+		{credentials.NewStaticV4("access", "secret", ""), credentials.SignatureV2, "AWS access:", "Signature", "signature"},
+		{credentials.NewStaticV2("access", "secret", ""), credentials.SignatureV4, "AWS4-HMAC-SHA256", "X-Amz-Signature", "x-amz-signature"},
+		{credentials.NewStaticV2("access", "secret", ""), credentials.SignatureDefault, "AWS access:", "Signature", "signature"},
+	}
+	for i, testCase := range testCases {
+		c, err := New(srv.Listener.Addr().String(), &Options{
+			Creds:         testCase.creds,
+			Region:        "us-east-1",
+			SignatureType: testCase.signatureType,
+		})
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if _, err = c.BucketExists(context.Background(), "bucket"); err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if !strings.HasPrefix(auth, testCase.authPrefix) {
+			t.Errorf("Test %d: expected authorization %q, got %q", i+1, testCase.authPrefix, auth)
+		}
+
+		u, err := c.PresignedGetObject(context.Background(), "bucket", "object", time.Hour, nil)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if u.Query().Get(testCase.presignParam) == "" {
+			t.Errorf("Test %d: expected %s in presigned URL %s", i+1, testCase.presignParam, u)
+		}
+
+		policy := NewPostPolicy()
+		policy.SetBucket("bucket")
+		policy.SetKey("object")
+		policy.SetExpires(time.Now().UTC().Add(time.Hour))
+		_, formData, err := c.PresignedPostPolicy(context.Background(), policy)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if formData[testCase.postParam] == "" {
+			t.Errorf("Test %d: expected %s in form data %v", i+1, testCase.postParam, formData)
+		}
+	}
+
+	// Forced signature V2 is kept for Amazon S3 endpoints.
+	c, err := New("s3.amazonaws.com", &Options{SignatureType: credentials.SignatureV2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.overrideSignerType.IsV2() {
+		t.Errorf("expected signature V2, got %s", c.overrideSignerType)
+	}
+
+	if _, err = New("localhost:9000", &Options{SignatureType: credentials.SignatureAnonymous}); err == nil {
+		t.Fatal("expected an error for an anonymous signature type")
+	}
+}
+
 // Tests bucket policy types.
 func TestBucketPolicyTypes(t *testing.T) {
 	want := map[string]bool{
@@ -228,3 +307,247 @@ func TestMakeTargetURL(t *testing.T) {
 		}
 	}
 }
+
+func TestTraceRedaction(t *testing.T) {
+	var key, token string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key = r.Header.Get(encrypt.SseCustomerKey)
+		token = r.Header.Get("X-Amz-Security-Token")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{
+		Creds:  credentials.NewStaticV4("access-key", "secret-key", "session-token"),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var trace bytes.Buffer
+	c.TraceOn(&trace)
+
+	sse := encrypt.DefaultPBKDF([]byte("password"), []byte("bucket/object"))
+	c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{ServerSideEncryption: sse})
+
+	if key == "" || token != "session-token" {
+		t.Fatalf("expected the request to carry the SSE-C key and session token")
+	}
+	for _, secret := range []string{key, token, "access-key"} {
+		if strings.Contains(trace.String(), secret) {
+			t.Errorf("trace contains secret %q:\n%s", secret, trace.String())
+		}
+	}
+	if !strings.Contains(trace.String(), "**REDACTED**") {
+		t.Errorf("trace is not redacted:\n%s", trace.String())
+	}
+}
+
+func TestAnonymousRequests(t *testing.T) {
+	var auth []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+	}))
+	defer srv.Close()
+
+	for _, creds := range []*credentials.Credentials{nil, credentials.NewStaticV4("", "", "")} {
+		auth = nil
+		c, err := New(srv.Listener.Addr().String(), &Options{Creds: creds, Region: "us-east-1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = c.BucketExists(context.Background(), "bucket"); err != nil {
+			t.Fatal(err)
+		}
+		if len(auth) != 1 || auth[0] != "" {
+			t.Errorf("expected a single unsigned request, got %q", auth)
+		}
+		if _, err = c.PresignedGetObject(context.Background(), "bucket", "object", time.Hour, nil); err == nil {
+			t.Error("expected presigning without credentials to fail")
+		}
+	}
+}
+
+func TestBucketLookupMakeBucket(t *testing.T) {
+	testCases := []struct {
+		lookup       BucketLookupType
+		expectedHost string
+		expectedPath string
+	}{
+		{BucketLookupAuto, "localhost:9000", "/bucket/"},
+		{BucketLookupPath, "localhost:9000", "/bucket/"},
+		{BucketLookupDNS, "bucket.localhost:9000", "/"},
+	}
+	for i, testCase := range testCases {
+		rt := &InterceptRouteTripper{}
+		c, err := New("localhost:9000", &Options{
+			Creds:        credentials.NewStaticV4("access", "secret", ""),
+			Transport:    rt,
+			Region:       "us-east-1",
+			BucketLookup: testCase.lookup,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = c.MakeBucket(context.Background(), "bucket", MakeBucketOptions{}); err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if rt.request.URL.Host != testCase.expectedHost || rt.request.URL.Path != testCase.expectedPath {
+			t.Errorf("Test %d: expected %s%s, got %s%s", i+1, testCase.expectedHost, testCase.expectedPath, rt.request.URL.Host, rt.request.URL.Path)
+		}
+	}
+}
+
+func TestCorePutObjectPartUnsignedPayload(t *testing.T) {
+	testCases := []struct {
+		disableContentSha256 bool
+		expectedSha256       string
+	}{
+		{false, "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"},
+		{true, unsignedPayload},
+	}
+	for i, testCase := range testCases {
+		rt := &InterceptRouteTripper{}
+		c, err := NewCore("localhost:9000", &Options{
+			Creds:     credentials.NewStaticV4("access", "secret", ""),
+			Transport: rt,
+			Region:    "us-east-1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := []byte("part data")
+		_, err = c.PutObjectPart(context.Background(), "bucket", "object", "upload-1", 1, bytes.NewReader(data), int64(len(data)), PutObjectPartOptions{
+			DisableContentSha256: testCase.disableContentSha256,
+		})
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if sha256 := rt.request.Header.Get("X-Amz-Content-Sha256"); sha256 != testCase.expectedSha256 {
+			t.Errorf("Test %d: expected payload hash %s, got %s", i+1, testCase.expectedSha256, sha256)
+		}
+	}
+}
+
+func TestBucketExists(t *testing.T) {
+	testCases := []struct {
+		status       int
+		exists       bool
+		expectedCode string
+	}{
+		{http.StatusOK, true, ""},
+		{http.StatusNotFound, false, ""},
+		{http.StatusForbidden, false, "AccessDenied"},
+	}
+	for i, testCase := range testCases {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodHead {
+				t.Errorf("Test %d: unexpected request %s %s", i+1, r.Method, r.URL)
+			}
+			w.WriteHeader(testCase.status)
+		}))
+		c, err := New(srv.Listener.Addr().String(), &Options{
+			Creds:  credentials.NewStaticV4("access", "secret", ""),
+			Region: "us-east-1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		exists, err := c.BucketExists(context.Background(), "bucket")
+		srv.Close()
+		if exists != testCase.exists || ToErrorResponse(err).Code != testCase.expectedCode {
+			t.Errorf("Test %d: expected %v and code %q, got %v and %v", i+1, testCase.exists, testCase.expectedCode, exists, err)
+		}
+	}
+}
+
+func TestMakeBucketRegionObjectLock(t *testing.T) {
+	var (
+		lockHeader string
+		config     createBucketConfiguration
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		lockHeader = r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled")
+		xmlDecoder(r.Body, &config)
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.Listener.Addr().String(), &Options{
+		Creds: credentials.NewStaticV4("access", "secret", ""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.MakeBucket(context.Background(), "bucket", MakeBucketOptions{Region: "eu-west-1", ObjectLocking: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lockHeader != "true" || config.Location != "eu-west-1" {
+		t.Fatalf("Expected object lock and location constraint, got %q and %q", lockHeader, config.Location)
+	}
+	// The bucket region is cached for subsequent requests.
+	if location, ok := c.bucketLocCache.Get("bucket"); !ok || location != "eu-west-1" {
+		t.Fatalf("Expected the bucket region to be cached, got %q", location)
+	}
+}
+
+type testRequestSigner struct {
+	creds    credentials.Value
+	location string
+}
+
+func (s *testRequestSigner) SignRequest(req *http.Request, creds credentials.Value, location string) (*http.Request, error) {
+	s.creds, s.location = creds, location
+	req.Header.Set("Authorization", "Custom "+creds.AccessKeyID+":"+req.Header.Get("X-Appliance-Token"))
+	return req, nil
+}
+
+func TestRequestHookCustomSigner(t *testing.T) {
+	var auth []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		if _, ok := r.URL.Query()["location"]; ok {
+			io.WriteString(w, `<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">eu-west-1</LocationConstraint>`)
+		}
+	}))
+	defer srv.Close()
+
+	var hooked int
+	sign := &testRequestSigner{}
+	c, err := New(srv.Listener.Addr().String(), &Options{
+		Creds: credentials.NewStaticV4("access", "secret", ""),
+		RequestHook: func(req *http.Request) error {
+			hooked++
+			req.Header.Set("X-Appliance-Token", "token")
+			return nil
+		},
+		CustomSigner: sign,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.PutObject(context.Background(), "bucket", "object", strings.NewReader("data"), 4, PutObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	// The bucket location request is signed by the custom signer as well.
+	if hooked != 2 || len(auth) != 2 {
+		t.Fatalf("expected 2 hooked requests, got %d hooked and %d sent", hooked, len(auth))
+	}
+	for _, a := range auth {
+		if a != "Custom access:token" {
+			t.Fatalf("unexpected authorization %q", a)
+		}
+	}
+	if sign.creds.SecretAccessKey != "secret" || sign.location != "eu-west-1" {
+		t.Fatalf("unexpected signer arguments: %+v, %s", sign.creds, sign.location)
+	}
+
+	c.requestHook = func(*http.Request) error { return errors.New("hook failed") }
+	if _, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{}); err == nil || err.Error() != "hook failed" {
+		t.Fatalf("expected hook error, got %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// bandwidthChunkSize - maximum size of a single read of a bandwidth
+// limited reader, so that the data is sent and received smoothly.
+const bandwidthChunkSize = 32 * 1024
+
+// bandwidthLimiter - token bucket limiting the rate at which the data
+// of requests is sent and received, shared by concurrent requests.
+// A full bucket holds the data of one second.
+type bandwidthLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // bytes per second
+	tokens float64 // may become negative, the deficit is waited for
+	last   time.Time
+}
+
+// newBandwidthLimiter - returns a limiter of bytesPerSec, or nil which
+// does not limit if bytesPerSec is not positive.
+func newBandwidthLimiter(bytesPerSec int64) *bandwidthLimiter {
+	if bytesPerSec <= 0 {
+		return nil
+	}
+	return &bandwidthLimiter{
+		rate:   float64(bytesPerSec),
+		tokens: float64(bytesPerSec),
+		last:   time.Now(),
+	}
+}
+
+// wait - takes n bytes from the bucket, waits until the bucket has
+// been refilled if taking them exceeded the rate.
+func (l *bandwidthLimiter) wait(ctx context.Context, n int) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += l.rate * now.Sub(l.last).Seconds()
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = now
+	l.tokens -= float64(n)
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// bandwidthReader - reader limiting the rate of the data read.
+type bandwidthReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *bandwidthLimiter
+}
+
+func newBandwidthReader(ctx context.Context, reader io.Reader, limiter *bandwidthLimiter) *bandwidthReader {
+	return &bandwidthReader{ctx: ctx, reader: reader, limiter: limiter}
+}
+
+func (r *bandwidthReader) Read(p []byte) (int, error) {
+	if len(p) > bandwidthChunkSize {
+		p = p[:bandwidthChunkSize]
+	}
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		if werr := r.limiter.wait(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+// objectBandwidth - returns the limiter of an object transfer, the
+// limiter shared by the requests of the call if one is set, a new one
+// if the call has a limit, otherwise the limiter of the client.
+func (c *Client) objectBandwidth(limiter *bandwidthLimiter, limit int64) *bandwidthLimiter {
+	if limiter != nil {
+		return limiter
+	}
+	if limit > 0 {
+		return newBandwidthLimiter(limit)
+	}
+	return c.bandwidth
+}
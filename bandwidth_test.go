@@ -0,0 +1,100 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBandwidthReader(t *testing.T) {
+	if newBandwidthLimiter(0) != nil {
+		t.Fatal("expected no limiter without a limit")
+	}
+
+	// The first second of data is not delayed.
+	limiter := newBandwidthLimiter(64 * 1024)
+	start := time.Now()
+	n, err := io.Copy(io.Discard, newBandwidthReader(context.Background(), bytes.NewReader(make([]byte, 96*1024)), limiter))
+	if err != nil || n != 96*1024 {
+		t.Fatalf("unexpected copy result: %d, %v", n, err)
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("expected the copy to be limited, took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = io.Copy(io.Discard, newBandwidthReader(ctx, bytes.NewReader(make([]byte, 96*1024)), newBandwidthLimiter(1024)))
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestBandwidthLimit(t *testing.T) {
+	const size = 48 * 1024
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			io.Copy(io.Discard, r.Body)
+		case http.MethodGet:
+			w.Header().Set("Content-Length", "49152")
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			w.Write(make([]byte, size))
+		}
+	}))
+	defer srv.Close()
+
+	// Uploads are limited by the client.
+	c, err := New(srv.Listener.Addr().String(), &Options{Region: "us-east-1", BandwidthLimit: 32 * 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	_, err = c.PutObject(context.Background(), "bucket", "object", bytes.NewReader(make([]byte, size)), size,
+		PutObjectOptions{DisableContentSha256: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("expected the upload to be limited, took %s", elapsed)
+	}
+
+	// Downloads are limited by the call.
+	c, err = New(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start = time.Now()
+	obj, err := c.GetObject(context.Background(), "bucket", "object", GetObjectOptions{BandwidthLimit: 32 * 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	if n, err := io.Copy(io.Discard, obj); err != nil || n != size {
+		t.Fatalf("unexpected download result: %d, %v", n, err)
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("expected the download to be limited, took %s", elapsed)
+	}
+}
@@ -217,6 +217,16 @@ func (c *Client) getBucketLocationRequest(ctx context.Context, bucketName string
 		return nil, err
 	}
 
+	if c.requestHook != nil {
+		if err = c.requestHook(req); err != nil {
+			return nil, err
+		}
+	}
+	if c.customSigner != nil {
+		req.Header.Set("X-Amz-Content-Sha256", emptySHA256Hex)
+		return c.customSigner.SignRequest(req, value, "us-east-1")
+	}
+
 	var (
 		signerType      = value.SignerType
 		accessKeyID     = value.AccessKeyID
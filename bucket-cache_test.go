@@ -23,10 +23,14 @@ import (
 	"encoding/xml"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"path"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/signer"
@@ -358,3 +362,162 @@ func TestProcessBucketLocationResponse(t *testing.T) {
 		}
 	}
 }
+
+// Tests that a stale bucket region is replaced by the one from an
+// AuthorizationHeaderMalformed error and the request retried.
+func TestBucketLocationLearnedFromError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("location") {
+			// Location is not disclosed, client falls back to 'us-east-1'.
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`<Error><Code>AccessDenied</Code></Error>`))
+			return
+		}
+		if !strings.Contains(r.Header.Get("Authorization"), "/eu-west-1/s3/") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`<Error><Code>AuthorizationHeaderMalformed</Code><Region>eu-west-1</Region></Error>`))
+			return
+		}
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Creds: credentials.NewStaticV4("access", "secret", ""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader, _, _, err := clnt.getObject(context.Background(), "bucket", "object", GetObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello" {
+		t.Fatalf("Expected 'hello', got %q", data)
+	}
+	if location, _ := clnt.bucketLocCache.Get("bucket"); location != "eu-west-1" {
+		t.Fatalf("Expected cached location 'eu-west-1', got %q", location)
+	}
+}
+
+// Tests that a request signed with an explicit location is
+// retried with the region learned from the error.
+func TestBucketLocationLearnedFromErrorExplicitLocation(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Authorization"), "/us-east-1/s3/") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`<Error><Code>AuthorizationHeaderMalformed</Code><Region>us-east-1</Region></Error>`))
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+	}))
+	defer srv.Close()
+
+	clnt, err := New(srv.Listener.Addr().String(), &Options{
+		Creds: credentials.NewStaticV4("access", "secret", ""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = clnt.MakeBucket(context.Background(), "bucket", MakeBucketOptions{Region: "eu-west-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, "<LocationConstraint>eu-west-1</LocationConstraint>") {
+		t.Fatalf("Expected the bucket to be created in 'eu-west-1', got %q", body)
+	}
+	if location, _ := clnt.bucketLocCache.Get("bucket"); location != "eu-west-1" {
+		t.Fatalf("Expected cached location 'eu-west-1', got %q", location)
+	}
+}
+
+// Tests that an error naming the region the request was signed
+// with is not retried, even if the location is no longer cached.
+func TestBucketLocationErrorSameRegion(t *testing.T) {
+	var clnt *Client
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("location") {
+			w.Write([]byte(`<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></LocationConstraint>`))
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+		// The bucket is removed from the cache meanwhile.
+		clnt.bucketLocCache.Delete("bucket")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<Error><Code>AccessDenied</Code><Region>us-east-1</Region></Error>`))
+	}))
+	defer srv.Close()
+
+	var err error
+	clnt, err = New(srv.Listener.Addr().String(), &Options{
+		Creds: credentials.NewStaticV4("access", "secret", ""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, _, err = clnt.getObject(context.Background(), "bucket", "object", GetObjectOptions{})
+	if ToErrorResponse(err).Code != "AccessDenied" {
+		t.Fatalf("Expected AccessDenied, got %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("Expected a single request, got %d", n)
+	}
+}
+
+func TestBucketRegionRedirect(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["location"]; ok {
+			// A stale location, the bucket moved to eu-west-1.
+			w.Write([]byte(`<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></LocationConstraint>`))
+			return
+		}
+		requests = append(requests, r.Method)
+		if !strings.Contains(r.Header.Get("Authorization"), "/eu-west-1/") {
+			w.Header().Set("x-amz-bucket-region", "eu-west-1")
+			w.WriteHeader(http.StatusMovedPermanently)
+			if r.Method != http.MethodHead {
+				w.Write([]byte(`<Error><Code>PermanentRedirect</Code><Message>The bucket you are attempting to access must be addressed using the specified endpoint.</Message></Error>`))
+			}
+			return
+		}
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", `"etag"`)
+	}))
+	defer srv.Close()
+
+	for _, method := range []string{http.MethodHead, http.MethodGet} {
+		requests = nil
+		c, err := New(srv.Listener.Addr().String(), &Options{
+			Creds: credentials.NewStaticV4("access", "secret", ""),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if method == http.MethodHead {
+			_, err = c.StatObject(context.Background(), "bucket", "object", StatObjectOptions{})
+		} else {
+			_, err = c.GetBucketPolicy(context.Background(), "bucket")
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		if len(requests) != 2 {
+			t.Fatalf("%s: expected the request to be retried once, got %v", method, requests)
+		}
+		if location, ok := c.bucketLocCache.Get("bucket"); !ok || location != "eu-west-1" {
+			t.Fatalf("%s: expected cached location eu-west-1, got %q", method, location)
+		}
+	}
+}
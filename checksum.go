@@ -18,6 +18,7 @@
 package minio
 
 import (
+	"bytes"
 	"crypto/sha1"
 	"crypto/sha256"
 	"encoding/base64"
@@ -25,6 +26,7 @@ import (
 	"hash/crc32"
 	"io"
 	"math/bits"
+	"strings"
 )
 
 // ChecksumType contains information about the checksum type.
@@ -208,3 +210,71 @@ func (c Checksum) Raw() []byte {
 	}
 	return c.r
 }
+
+// objectChecksum returns the checksum of the whole object content
+// stored with the object. Checksums of multipart objects computed
+// from the part checksums cannot be verified against the content
+// and are not returned.
+func objectChecksum(objInfo ObjectInfo) Checksum {
+	for _, c := range []struct {
+		t     ChecksumType
+		value string
+	}{
+		{ChecksumCRC32C, objInfo.ChecksumCRC32C},
+		{ChecksumCRC32, objInfo.ChecksumCRC32},
+		{ChecksumSHA256, objInfo.ChecksumSHA256},
+		{ChecksumSHA1, objInfo.ChecksumSHA1},
+	} {
+		// Values of multipart objects have a "-<parts>" suffix.
+		if c.value == "" || strings.Contains(c.value, "-") {
+			continue
+		}
+		if checksum := NewChecksumString(c.t, c.value); checksum.IsSet() {
+			return checksum
+		}
+	}
+	return Checksum{}
+}
+
+// checksumVerifier verifies the data read sequentially from the start
+// of an object against the checksum stored with the object.
+type checksumVerifier struct {
+	bucketName string
+	objectName string
+
+	expected Checksum
+	hash     hash.Hash
+	offset   int64
+	disabled bool
+}
+
+// update hashes the data read at offset of the object, verification
+// stops if the object has no checksum or the data is not read
+// sequentially.
+func (v *checksumVerifier) update(objInfo ObjectInfo, offset int64, b []byte) {
+	if v == nil || v.disabled {
+		return
+	}
+	if v.hash == nil {
+		v.expected = objectChecksum(objInfo)
+		v.hash = v.expected.Type.Hasher()
+	}
+	if v.hash == nil || offset != v.offset {
+		v.disabled = true
+		return
+	}
+	v.hash.Write(b)
+	v.offset += int64(len(b))
+}
+
+// verify returns an error if all size bytes of the object were
+// hashed and do not match the checksum of the object.
+func (v *checksumVerifier) verify(size int64) error {
+	if v == nil || v.disabled || v.hash == nil || v.offset != size {
+		return nil
+	}
+	if actual := NewChecksum(v.expected.Type, v.hash.Sum(nil)); !bytes.Equal(actual.Raw(), v.expected.Raw()) {
+		return errChecksumMismatch(v.expected, actual, v.bucketName, v.objectName)
+	}
+	return nil
+}
@@ -46,13 +46,25 @@ func NewCore(endpoint string, opts *Options) (*Core, error) {
 // ListObjects - List all the objects at a prefix, optionally with marker and delimiter
 // you can further filter the results.
 func (c Core) ListObjects(bucket, prefix, marker, delimiter string, maxKeys int) (result ListBucketResult, err error) {
-	return c.listObjectsQuery(context.Background(), bucket, prefix, marker, delimiter, maxKeys, nil)
+	return c.ListObjectsWithContext(context.Background(), bucket, prefix, marker, delimiter, maxKeys)
+}
+
+// ListObjectsWithContext - same as ListObjects with a context to control
+// cancellations and timeouts.
+func (c Core) ListObjectsWithContext(ctx context.Context, bucket, prefix, marker, delimiter string, maxKeys int) (result ListBucketResult, err error) {
+	return c.listObjectsQuery(ctx, bucket, prefix, marker, delimiter, maxKeys, nil)
 }
 
 // ListObjectsV2 - Lists all the objects at a prefix, similar to ListObjects() but uses
 // continuationToken instead of marker to support iteration over the results.
 func (c Core) ListObjectsV2(bucketName, objectPrefix, startAfter, continuationToken, delimiter string, maxkeys int) (ListBucketV2Result, error) {
-	return c.listObjectsV2Query(context.Background(), bucketName, objectPrefix, continuationToken, true, false, delimiter, startAfter, maxkeys, nil)
+	return c.ListObjectsV2WithContext(context.Background(), bucketName, objectPrefix, startAfter, continuationToken, delimiter, maxkeys)
+}
+
+// ListObjectsV2WithContext - same as ListObjectsV2 with a context to
+// control cancellations and timeouts.
+func (c Core) ListObjectsV2WithContext(ctx context.Context, bucketName, objectPrefix, startAfter, continuationToken, delimiter string, maxkeys int) (ListBucketV2Result, error) {
+	return c.listObjectsV2Query(ctx, bucketName, objectPrefix, continuationToken, true, false, delimiter, startAfter, maxkeys, nil)
 }
 
 // CopyObject - copies an object from source object to destination object on server side.
@@ -91,6 +103,11 @@ type PutObjectPartOptions struct {
 	Md5Base64, Sha256Hex  string
 	SSE                   encrypt.ServerSide
 	CustomHeader, Trailer http.Header
+
+	// DisableContentSha256 sends the part with an unsigned payload
+	// instead of a streaming signature over plain HTTP, the same way
+	// as PutObjectOptions.DisableContentSha256.
+	DisableContentSha256 bool
 }
 
 // PutObjectPart - Upload an object part.
@@ -107,7 +124,7 @@ func (c Core) PutObjectPart(ctx context.Context, bucket, object, uploadID string
 		sha256Hex:    opts.Sha256Hex,
 		size:         size,
 		sse:          opts.SSE,
-		streamSha256: true,
+		streamSha256: !opts.DisableContentSha256,
 		customHeader: opts.CustomHeader,
 		trailer:      opts.Trailer,
 	}
@@ -20,9 +20,11 @@ package minio
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"testing"
@@ -896,3 +898,26 @@ func TestCoreMultipartUpload(t *testing.T) {
 		t.Fatal("Error: ", err)
 	}
 }
+
+// Tests that Core list calls honor the context deadline.
+func TestCoreListObjectsWithContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		// Never answer, wait for the client to give up.
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	c, err := NewCore(srv.Listener.Addr().String(), &Options{Region: "us-east-1"})
+	if err != nil {
+		t.Fatal("Error:", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err = c.ListObjectsWithContext(ctx, "bucket", "", "", "", 1000); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ListObjectsWithContext: expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if _, err = c.ListObjectsV2WithContext(ctx, "bucket", "", "", "", "", 1000); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ListObjectsV2WithContext: expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
@@ -0,0 +1,99 @@
+//go:build example
+// +build example
+
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func main() {
+	// Note: YOUR-ACCESSKEYID, YOUR-SECRETACCESSKEY, my-ca.crt, my-client.crt,
+	// my-client.key and my-proxy:3128 are dummy values, please replace them
+	// with original values.
+
+	// Start from the default transport of the library, it keeps compressed
+	// objects as they are and only uses TLS 1.2 or newer.
+	tr, err := minio.DefaultTransport(true)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Trust a custom CA bundle in addition to the system CAs.
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	caCert, err := os.ReadFile("my-ca.crt")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	rootCAs.AppendCertsFromPEM(caCert)
+	tr.TLSClientConfig.RootCAs = rootCAs
+
+	// Authenticate with a client certificate.
+	clientCert, err := tls.LoadX509KeyPair("my-client.crt", "my-client.key")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	tr.TLSClientConfig.Certificates = []tls.Certificate{clientCert}
+
+	// Send all requests through a proxy.
+	proxyURL, err := url.Parse("http://my-proxy:3128")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	tr.Proxy = http.ProxyURL(proxyURL)
+
+	// Tune the dial timeout and the connection pool.
+	tr.DialContext = (&net.Dialer{
+		Timeout:   5 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	tr.MaxIdleConnsPerHost = 64
+
+	s3Client, err := minio.New("s3.amazonaws.com", &minio.Options{
+		Creds:     credentials.NewStaticV4("YOUR-ACCESSKEYID", "YOUR-SECRETACCESSKEY", ""),
+		Secure:    true,
+		Transport: tr,
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	buckets, err := s3Client.ListBuckets(context.Background())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	for _, bucket := range buckets {
+		log.Println(bucket.Name)
+	}
+}
@@ -5979,8 +5979,8 @@ func testSSECEncryptedGetObjectReadSeekFunctional() {
 		{1, 1, 1, nil, true, 1, bufSize},
 		// Move larger than possible
 		{int64(bufSize), 1, 0, io.EOF, false, 0, 0},
-		// Provide negative offset with CUR_SEEK
-		{int64(-1), 1, 0, fmt.Errorf("Negative position not allowed for 1"), false, 0, 0},
+		// Provide negative offset before the start with CUR_SEEK
+		{-int64(bufSize) * 2, 1, 0, fmt.Errorf("Seeking at negative offset not allowed for 1"), false, 0, 0},
 		// Test with whence SEEK_END and with positive offset
 		{1024, 2, 0, io.EOF, false, 0, 0},
 		// Test with whence SEEK_END and with negative offset
@@ -6160,8 +6160,8 @@ func testSSES3EncryptedGetObjectReadSeekFunctional() {
 		{1, 1, 1, nil, true, 1, bufSize},
 		// Move larger than possible
 		{int64(bufSize), 1, 0, io.EOF, false, 0, 0},
-		// Provide negative offset with CUR_SEEK
-		{int64(-1), 1, 0, fmt.Errorf("Negative position not allowed for 1"), false, 0, 0},
+		// Provide negative offset before the start with CUR_SEEK
+		{-int64(bufSize) * 2, 1, 0, fmt.Errorf("Seeking at negative offset not allowed for 1"), false, 0, 0},
 		// Test with whence SEEK_END and with positive offset
 		{1024, 2, 0, io.EOF, false, 0, 0},
 		// Test with whence SEEK_END and with negative offset
@@ -67,3 +67,33 @@ func TestHealthCheck(t *testing.T) {
 		t.Fatal("Expected online but found offline")
 	}
 }
+
+func TestHealthCheckRegion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	addr := srv.Listener.Addr().String()
+	srv.Close()
+
+	// The endpoint must be probed even though the region
+	// of the client is known.
+	clnt, err := New(addr, &Options{
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hcancel, err := clnt.HealthCheck(1 * time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hcancel()
+
+	if !clnt.IsOffline() {
+		t.Fatal("Expected offline but found online")
+	}
+	if _, err = clnt.BucketExists(context.Background(), "bucket"); err == nil {
+		t.Fatal("Expected requests to fail while offline")
+	}
+}
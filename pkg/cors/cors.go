@@ -0,0 +1,88 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cors implements the CORS configuration of buckets.
+package cors
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// maxRules is the maximum number of rules of a CORS configuration.
+const maxRules = 100
+
+// Rule is a CORS rule allowing cross-origin requests of the origins
+// and methods specified.
+type Rule struct {
+	ID            string   `xml:"ID,omitempty"`
+	AllowedHeader []string `xml:"AllowedHeader,omitempty"`
+	AllowedMethod []string `xml:"AllowedMethod"`
+	AllowedOrigin []string `xml:"AllowedOrigin"`
+	ExposeHeader  []string `xml:"ExposeHeader,omitempty"`
+	MaxAgeSeconds int      `xml:"MaxAgeSeconds,omitempty"`
+}
+
+// Config is the CORS configuration of a bucket.
+type Config struct {
+	XMLName   xml.Name `xml:"CORSConfiguration"`
+	CORSRules []Rule   `xml:"CORSRule"`
+}
+
+// NewConfig returns a CORS configuration with the rules.
+func NewConfig(rules []Rule) *Config {
+	return &Config{CORSRules: rules}
+}
+
+// Validate checks the rules of the configuration.
+func (c *Config) Validate() error {
+	if len(c.CORSRules) == 0 {
+		return errors.New("CORS configuration must have at least one rule")
+	}
+	if len(c.CORSRules) > maxRules {
+		return fmt.Errorf("CORS configuration cannot have more than %d rules", maxRules)
+	}
+	for i, rule := range c.CORSRules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("CORS rule %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the rule allows origins and supported methods.
+func (r Rule) Validate() error {
+	if len(r.AllowedOrigin) == 0 {
+		return errors.New("at least one allowed origin must be set")
+	}
+	if len(r.AllowedMethod) == 0 {
+		return errors.New("at least one allowed method must be set")
+	}
+	for _, method := range r.AllowedMethod {
+		switch method {
+		case http.MethodGet, http.MethodPut, http.MethodHead, http.MethodPost, http.MethodDelete:
+		default:
+			return fmt.Errorf("unsupported allowed method %q", method)
+		}
+	}
+	if r.MaxAgeSeconds < 0 {
+		return errors.New("max age cannot be negative")
+	}
+	return nil
+}
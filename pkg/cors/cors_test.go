@@ -0,0 +1,75 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cors
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestConfigXML(t *testing.T) {
+	config := NewConfig([]Rule{{
+		AllowedMethod: []string{"GET", "PUT"},
+		AllowedOrigin: []string{"https://example.com"},
+		MaxAgeSeconds: 3000,
+	}})
+	expected := `<CORSConfiguration><CORSRule><AllowedMethod>GET</AllowedMethod><AllowedMethod>PUT</AllowedMethod><AllowedOrigin>https://example.com</AllowedOrigin><MaxAgeSeconds>3000</MaxAgeSeconds></CORSRule></CORSConfiguration>`
+
+	buf, err := xml.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != expected {
+		t.Fatalf("expected %s, got %s", expected, buf)
+	}
+
+	var decoded Config
+	if err = xml.Unmarshal(buf, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.CORSRules, config.CORSRules) {
+		t.Fatalf("expected %+v, got %+v", config.CORSRules, decoded.CORSRules)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	origins := []string{"*"}
+	testCases := []struct {
+		rules   []Rule
+		success bool
+	}{
+		{[]Rule{{AllowedMethod: []string{"GET"}, AllowedOrigin: origins}}, true},
+		{[]Rule{{AllowedMethod: []string{"GET", "HEAD", "PUT", "POST", "DELETE"}, AllowedOrigin: origins}}, true},
+		{nil, false},
+		{make([]Rule, maxRules+1), false},
+		{[]Rule{{AllowedMethod: []string{"GET"}}}, false},
+		{[]Rule{{AllowedOrigin: origins}}, false},
+		{[]Rule{{AllowedMethod: []string{"PATCH"}, AllowedOrigin: origins}}, false},
+		{[]Rule{{AllowedMethod: []string{"GET"}, AllowedOrigin: origins, MaxAgeSeconds: -1}}, false},
+	}
+	for i, testCase := range testCases {
+		err := NewConfig(testCase.rules).Validate()
+		if testCase.success && err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i+1, err)
+		}
+		if !testCase.success && err == nil {
+			t.Errorf("Test %d: expected an error", i+1)
+		}
+	}
+}
@@ -97,7 +97,7 @@ type STSAssumeRoleOptions struct {
 	Policy       string // Optional to assign a policy to the assumed role
 
 	Location        string // Optional commonly needed with AWS STS.
-	DurationSeconds int    // Optional defaults to 1 hour.
+	DurationSeconds int    // Optional defaults to 1 hour, raised to at least 15 minutes.
 
 	// Optional only valid if using with AWS STS
 	RoleARN         string
@@ -123,7 +123,11 @@ func NewSTSAssumeRole(stsEndpoint string, opts STSAssumeRoleOptions) (*Credentia
 	}), nil
 }
 
-const defaultDurationSeconds = 3600
+const (
+	defaultDurationSeconds = 3600
+	// STS rejects durations shorter than 15 minutes.
+	minDurationSeconds = 900
+)
 
 // closeResponse close non nil response with any response Body.
 // convenient wrapper to drain any remaining data on response body.
@@ -155,8 +159,12 @@ func getAssumeRoleCredentials(clnt *http.Client, endpoint string, opts STSAssume
 	if opts.RoleSessionName != "" {
 		v.Set("RoleSessionName", opts.RoleSessionName)
 	}
-	if opts.DurationSeconds > defaultDurationSeconds {
-		v.Set("DurationSeconds", strconv.Itoa(opts.DurationSeconds))
+	if opts.DurationSeconds > 0 {
+		duration := opts.DurationSeconds
+		if duration < minDurationSeconds {
+			duration = minDurationSeconds
+		}
+		v.Set("DurationSeconds", strconv.Itoa(duration))
 	} else {
 		v.Set("DurationSeconds", strconv.Itoa(defaultDurationSeconds))
 	}
@@ -0,0 +1,72 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credentials
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAssumeRoleDuration(t *testing.T) {
+	var duration string
+	expiration := time.Now().UTC().Add(15 * time.Minute).Truncate(time.Second)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.Form.Get("Action") != "AssumeRole" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		duration = r.Form.Get("DurationSeconds")
+		w.Write([]byte(`<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult><Credentials>` +
+			`<AccessKeyId>access</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken>` +
+			`<Expiration>` + expiration.Format(time.RFC3339) + `</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`))
+	}))
+	defer srv.Close()
+
+	testCases := []struct {
+		durationSeconds int
+		expected        string
+	}{
+		{0, "3600"},
+		{1, "900"},
+		{899, "900"},
+		{900, "900"},
+		{7200, "7200"},
+	}
+	for i, testCase := range testCases {
+		creds, err := NewSTSAssumeRole(srv.URL, STSAssumeRoleOptions{
+			AccessKey:       "minio",
+			SecretKey:       "minio123",
+			DurationSeconds: testCase.durationSeconds,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := creds.Get()
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if duration != testCase.expected {
+			t.Errorf("Test %d: expected DurationSeconds %s, got %s", i+1, testCase.expected, duration)
+		}
+		if v.SessionToken != "token" || !v.Expiration.Equal(expiration) {
+			t.Errorf("Test %d: unexpected credentials %+v", i+1, v)
+		}
+	}
+}
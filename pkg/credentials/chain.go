@@ -33,7 +33,7 @@ package credentials
 //
 //	creds := credentials.NewChainCredentials(
 //	    []credentials.Provider{
-//	        &credentials.EnvAWSS3{},
+//	        &credentials.EnvAWS{},
 //	        &credentials.EnvMinio{},
 //	    })
 //
@@ -63,8 +63,9 @@ func NewChainCredentials(providers []Provider) *Credentials {
 func (c *Chain) Retrieve() (Value, error) {
 	for _, p := range c.Providers {
 		creds, _ := p.Retrieve()
-		// Always prioritize non-anonymous providers, if any.
-		if creds.AccessKeyID == "" && creds.SecretAccessKey == "" {
+		// Always prioritize non-anonymous providers, if any. A partial
+		// value, such as an access key without its secret, is skipped.
+		if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
 			continue
 		}
 		c.curr = p
@@ -42,6 +42,11 @@ func TestChainGet(t *testing.T) {
 		Providers: []Provider{
 			&credProvider{err: errors.New("FirstError")},
 			&credProvider{err: errors.New("SecondError")},
+			&testCredProvider{
+				creds: Value{
+					AccessKeyID: "AKIP",
+				},
+			},
 			&testCredProvider{
 				creds: Value{
 					AccessKeyID:     "AKIF",
@@ -19,7 +19,6 @@ package credentials
 
 import (
 	"encoding/json"
-	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -110,9 +109,6 @@ func (p *FileAWSCredentials) Retrieve() (Value, error) {
 	credentialProcess := strings.TrimSpace(iniProfile.Key("credential_process").String())
 	if credentialProcess != "" {
 		args := strings.Fields(credentialProcess)
-		if len(args) <= 1 {
-			return Value{}, errors.New("invalid credential process args")
-		}
 		cmd := exec.Command(args[0], args[1:]...)
 		out, err := cmd.Output()
 		if err != nil {
@@ -147,6 +147,36 @@ func TestFileAWS(t *testing.T) {
 	}
 }
 
+func TestFileAWSProcessWithoutArgs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not executable")
+	}
+	dir := t.TempDir()
+	process := filepath.Join(dir, "credential-process")
+	data, err := os.ReadFile("credentials.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(process, append([]byte("#!/bin/sh\ncat <<EOF\n"), append(data, "\nEOF\n"...)...), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "credentials")
+	if err = os.WriteFile(filename, []byte("[default]\ncredential_process = "+process+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	credValues, err := NewFileAWSCredentials(filename, "default").Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credValues.AccessKeyID != "accessKey" {
+		t.Errorf("Expected 'accessKey', got %s'", credValues.AccessKeyID)
+	}
+	if credValues.SessionToken != "token" {
+		t.Errorf("Expected 'token', got %s'", credValues.SessionToken)
+	}
+}
+
 func TestFileMinioClient(t *testing.T) {
 	os.Clearenv()
 
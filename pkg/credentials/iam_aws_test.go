@@ -334,6 +334,51 @@ func TestEcsTaskFullURI(t *testing.T) {
 	}
 }
 
+func TestEcsTaskAuthorizationToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "auth-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintf(w, credsRespEcsTaskTmpl, "2014-12-16T01:51:37Z")
+	}))
+	defer server.Close()
+
+	p := &IAM{
+		Client: http.DefaultClient,
+	}
+	p.Container.AuthorizationToken = "auth-token"
+	p.Container.CredentialsFullURI = server.URL + "/v2/credentials"
+	creds, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("Unexpected failure %s", err)
+	}
+	if "accessKey" != creds.AccessKeyID {
+		t.Errorf("Expected \"accessKey\", got %s", creds.AccessKeyID)
+	}
+
+	f, err := os.CreateTemp("", "minio-go")
+	if err != nil {
+		t.Fatalf("Unexpected failure %s", err)
+	}
+	defer os.Remove(f.Name())
+	f.Write([]byte("auth-token"))
+	f.Close()
+
+	p = &IAM{
+		Client: http.DefaultClient,
+	}
+	p.Container.AuthorizationTokenFile = f.Name()
+	p.Container.CredentialsFullURI = server.URL + "/v2/credentials"
+	creds, err = p.Retrieve()
+	if err != nil {
+		t.Fatalf("Unexpected failure %s", err)
+	}
+	if "token" != creds.SessionToken {
+		t.Errorf("Expected \"token\", got %s", creds.SessionToken)
+	}
+}
+
 func TestSts(t *testing.T) {
 	server := initStsTestServer("2014-12-16T01:51:37Z")
 	defer server.Close()
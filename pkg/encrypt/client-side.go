@@ -0,0 +1,245 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encrypt
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	// ClientSideAlgorithm is the client-side encryption algorithm,
+	// the data is split into chunks sealed with AES-256-GCM.
+	ClientSideAlgorithm = "AES256-GCM-CHUNKED"
+
+	// ClientSideChunkSize is the size of the plaintext chunks.
+	ClientSideChunkSize = 64 * 1024
+
+	// ClientSideNonceSize is the size of the nonce of a stream.
+	ClientSideNonceSize = 12
+
+	clientSideKeySize = 32
+	clientSideTagSize = 16
+)
+
+var (
+	errClientSideKeySize   = errors.New("encrypt: client-side encryption key must be 256 bit long")
+	errClientSideNonceSize = errors.New("encrypt: client-side encryption nonce must be 96 bit long")
+	errClientSideCorrupted = errors.New("encrypt: client-side encrypted data is corrupted or truncated")
+)
+
+// MasterKey wraps the random content keys of client-side encrypted
+// objects. It can be implemented to wrap the content keys with a KMS.
+type MasterKey interface {
+	// WrapKey encrypts the content key of an object.
+	WrapKey(contentKey []byte) ([]byte, error)
+
+	// UnwrapKey decrypts a content key encrypted with WrapKey.
+	UnwrapKey(wrappedKey []byte) ([]byte, error)
+}
+
+// NewMasterKey returns a MasterKey wrapping content keys with AES-GCM
+// using the provided key. The key must be 32 bytes long.
+func NewMasterKey(key []byte) (MasterKey, error) {
+	if len(key) != clientSideKeySize {
+		return nil, errClientSideKeySize
+	}
+	aead, err := newClientSideAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return masterKey{aead: aead}, nil
+}
+
+type masterKey struct {
+	aead cipher.AEAD
+}
+
+func (m masterKey) WrapKey(contentKey []byte) ([]byte, error) {
+	nonce := make([]byte, m.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return m.aead.Seal(nonce, nonce, contentKey, nil), nil
+}
+
+func (m masterKey) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	if len(wrappedKey) < m.aead.NonceSize() {
+		return nil, errors.New("encrypt: wrapped key is too short")
+	}
+	nonce, ciphertext := wrappedKey[:m.aead.NonceSize()], wrappedKey[m.aead.NonceSize():]
+	return m.aead.Open(nil, nonce, ciphertext, nil)
+}
+
+// NewContentKey returns a random content key and stream nonce
+// for client-side encryption.
+func NewContentKey() (key, nonce []byte, err error) {
+	buf := make([]byte, clientSideKeySize+ClientSideNonceSize)
+	if _, err = io.ReadFull(rand.Reader, buf); err != nil {
+		return nil, nil, err
+	}
+	return buf[:clientSideKeySize], buf[clientSideKeySize:], nil
+}
+
+// EncryptedSize returns the size of the client-side encrypted data
+// for a plaintext of the given size, -1 is returned for unknown sizes.
+func EncryptedSize(size int64) int64 {
+	if size < 0 {
+		return -1
+	}
+	chunks := (size + ClientSideChunkSize - 1) / ClientSideChunkSize
+	if chunks == 0 {
+		// Empty data is sealed as one empty chunk.
+		chunks = 1
+	}
+	return size + chunks*clientSideTagSize
+}
+
+// DecryptedSize returns the size of the plaintext of client-side
+// encrypted data of the given size.
+func DecryptedSize(size int64) (int64, error) {
+	const encChunkSize = ClientSideChunkSize + clientSideTagSize
+	chunks := (size + encChunkSize - 1) / encChunkSize
+	if size < clientSideTagSize || size-chunks*clientSideTagSize < (chunks-1)*ClientSideChunkSize {
+		return 0, errClientSideCorrupted
+	}
+	return size - chunks*clientSideTagSize, nil
+}
+
+// NewEncryptReader returns a reader of the data read from src
+// encrypted with the content key and stream nonce.
+func NewEncryptReader(src io.Reader, key, nonce []byte) (io.Reader, error) {
+	s, err := newClientSideStream(src, key, nonce, ClientSideChunkSize)
+	if err != nil {
+		return nil, err
+	}
+	s.seal = true
+	return s, nil
+}
+
+// NewDecryptReader returns a reader of the data read from src
+// decrypted with the content key and stream nonce. Reads fail
+// if the data was modified or truncated.
+func NewDecryptReader(src io.Reader, key, nonce []byte) (io.Reader, error) {
+	return newClientSideStream(src, key, nonce, ClientSideChunkSize+clientSideTagSize)
+}
+
+func newClientSideAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// clientSideStream seals or opens the chunks of a stream, the nonce of
+// a chunk is the stream nonce with its sequence number added and
+// the additional data marks the final chunk, so that reordered,
+// dropped or truncated chunks fail to open.
+type clientSideStream struct {
+	src   *bufio.Reader
+	aead  cipher.AEAD
+	nonce []byte
+	seal  bool
+
+	seq  uint64
+	in   []byte
+	buf  []byte
+	out  []byte
+	done bool
+	err  error
+}
+
+func newClientSideStream(src io.Reader, key, nonce []byte, chunkSize int) (*clientSideStream, error) {
+	if len(key) != clientSideKeySize {
+		return nil, errClientSideKeySize
+	}
+	if len(nonce) != ClientSideNonceSize {
+		return nil, errClientSideNonceSize
+	}
+	aead, err := newClientSideAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &clientSideStream{
+		src:   bufio.NewReader(src),
+		aead:  aead,
+		nonce: append([]byte(nil), nonce...),
+		in:    make([]byte, chunkSize),
+		buf:   make([]byte, 0, chunkSize+clientSideTagSize),
+	}, nil
+}
+
+func (s *clientSideStream) Read(p []byte) (int, error) {
+	for len(s.out) == 0 {
+		if s.err != nil {
+			return 0, s.err
+		}
+		if s.done {
+			return 0, io.EOF
+		}
+		s.err = s.next()
+	}
+	n := copy(p, s.out)
+	s.out = s.out[n:]
+	return n, nil
+}
+
+// next reads, seals or opens the next chunk of the stream.
+func (s *clientSideStream) next() error {
+	n, err := io.ReadFull(s.src, s.in)
+	switch err {
+	case nil:
+		// A full chunk is final when nothing follows it.
+		if _, err = s.src.Peek(1); err == io.EOF {
+			s.done = true
+		} else if err != nil {
+			return err
+		}
+	case io.EOF, io.ErrUnexpectedEOF:
+		s.done = true
+	default:
+		return err
+	}
+
+	nonce := make([]byte, len(s.nonce))
+	copy(nonce, s.nonce)
+	binary.BigEndian.PutUint64(nonce[4:], binary.BigEndian.Uint64(nonce[4:])+s.seq)
+	s.seq++
+
+	additionalData := []byte{0}
+	if s.done {
+		additionalData[0] = 1
+	}
+	if s.seal {
+		s.out = s.aead.Seal(s.buf[:0], nonce, s.in[:n], additionalData)
+		return nil
+	}
+	if n < clientSideTagSize {
+		return errClientSideCorrupted
+	}
+	if s.out, err = s.aead.Open(s.buf[:0], nonce, s.in[:n], additionalData); err != nil {
+		return errClientSideCorrupted
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encrypt
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+)
+
+func TestClientSideStream(t *testing.T) {
+	key, nonce, err := NewContentKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, size := range []int{0, 1, ClientSideChunkSize - 1, ClientSideChunkSize, ClientSideChunkSize + 1, 3 * ClientSideChunkSize} {
+		data := make([]byte, size)
+		if _, err = rand.Read(data); err != nil {
+			t.Fatal(err)
+		}
+		encReader, err := NewEncryptReader(bytes.NewReader(data), key, nonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		encrypted, err := io.ReadAll(encReader)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if int64(len(encrypted)) != EncryptedSize(int64(size)) {
+			t.Errorf("Test %d: expected encrypted size %d, got %d", i+1, EncryptedSize(int64(size)), len(encrypted))
+		}
+		if decSize, err := DecryptedSize(int64(len(encrypted))); err != nil || decSize != int64(size) {
+			t.Errorf("Test %d: expected decrypted size %d, got %d, %v", i+1, size, decSize, err)
+		}
+
+		decReader, err := NewDecryptReader(bytes.NewReader(encrypted), key, nonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decrypted, err := io.ReadAll(decReader)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if !bytes.Equal(data, decrypted) {
+			t.Errorf("Test %d: decrypted data does not match", i+1)
+		}
+
+		// Modified data must not decrypt.
+		modified := append([]byte(nil), encrypted...)
+		modified[len(modified)/2] ^= 1
+		if _, err = io.ReadAll(mustDecryptReader(t, modified, key, nonce)); err == nil {
+			t.Errorf("Test %d: expected an error for modified data", i+1)
+		}
+		// Data truncated at a chunk boundary must not decrypt.
+		if len(encrypted) > ClientSideChunkSize+clientSideTagSize {
+			truncated := encrypted[:ClientSideChunkSize+clientSideTagSize]
+			if _, err = io.ReadAll(mustDecryptReader(t, truncated, key, nonce)); err == nil {
+				t.Errorf("Test %d: expected an error for truncated data", i+1)
+			}
+		}
+	}
+}
+
+func mustDecryptReader(t *testing.T, data, key, nonce []byte) io.Reader {
+	t.Helper()
+	r, err := NewDecryptReader(bytes.NewReader(data), key, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestMasterKey(t *testing.T) {
+	if _, err := NewMasterKey(make([]byte, 16)); err == nil {
+		t.Error("expected an error for a 128 bit master key")
+	}
+	masterKey, err := NewMasterKey(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	contentKey, _, err := NewContentKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrappedKey, err := masterKey.WrapKey(contentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unwrappedKey, err := masterKey.UnwrapKey(wrappedKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(contentKey, unwrappedKey) {
+		t.Error("unwrapped key does not match the content key")
+	}
+
+	otherKey, err := NewMasterKey(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = otherKey.UnwrapKey(wrappedKey); err == nil {
+		t.Error("expected an error unwrapping with another master key")
+	}
+}
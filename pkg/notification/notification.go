@@ -70,6 +70,42 @@ const (
 	BucketRemovedAll                                   EventType = "s3:BucketRemoved:*"
 )
 
+// eventCategories - known categories of S3 and MinIO notification
+// event types, e.g. 'ObjectCreated' in 's3:ObjectCreated:Put'
+var eventCategories = set.CreateStringSet(
+	"ObjectCreated", "ObjectRemoved", "ObjectAccessed", "ObjectRestore",
+	"ObjectTransition", "ObjectTagging", "ObjectAcl", "ReducedRedundancyLostObject",
+	"Replication", "LifecycleExpiration", "LifecycleTransition",
+	"LifecycleDelMarkerExpiration", "IntelligentTiering", "Scanner",
+	"BucketCreated", "BucketRemoved",
+)
+
+// IsValid returns true if the event type is well-formed and of a known
+// category, e.g. 's3:ObjectCreated:*' or 's3:ReducedRedundancyLostObject'.
+func (e EventType) IsValid() bool {
+	parts := strings.Split(string(e), ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] != "s3" {
+		return false
+	}
+	if !eventCategories.Contains(parts[1]) {
+		return false
+	}
+	if len(parts) == 3 {
+		if parts[2] == "*" {
+			return true
+		}
+		if parts[2] == "" {
+			return false
+		}
+		for _, r := range parts[2] {
+			if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // FilterRule - child of S3Key, a tag in the notification xml which
 // carries suffix/prefix filters
 type FilterRule struct {
@@ -224,6 +260,19 @@ func EqualFilterRuleList(a, b []FilterRule) bool {
 	return setA.Difference(setB).IsEmpty()
 }
 
+// equalFilter tells whether a and b have the same filter rules,
+// a nil filter has no rules.
+func equalFilter(a, b *Filter) bool {
+	var rulesA, rulesB []FilterRule
+	if a != nil {
+		rulesA = a.S3Key.FilterRules
+	}
+	if b != nil {
+		rulesB = b.S3Key.FilterRules
+	}
+	return EqualFilterRuleList(rulesA, rulesB)
+}
+
 // Equal returns whether this `Config` is equal to another defined by the passed parameters
 func (t *Config) Equal(events []EventType, prefix, suffix string) bool {
 	if t == nil {
@@ -277,12 +326,59 @@ type Configuration struct {
 	QueueConfigs  []QueueConfig  `xml:"QueueConfiguration"`
 }
 
+// ErrNoEvents is returned when a notification config has no events
+var ErrNoEvents = errors.New("notification config must have at least one event")
+
+// validate checks the events and the filter rules of the config.
+func (t Config) validate() error {
+	if len(t.Events) == 0 {
+		return ErrNoEvents
+	}
+	for _, event := range t.Events {
+		if !event.IsValid() {
+			return fmt.Errorf("invalid notification event type %q", event)
+		}
+	}
+	if t.Filter != nil {
+		for _, rule := range t.Filter.S3Key.FilterRules {
+			// AWS returns the rule names capitalized, accept them as well
+			// so a config read from the server can be set again.
+			if !strings.EqualFold(rule.Name, "prefix") && !strings.EqualFold(rule.Name, "suffix") {
+				return fmt.Errorf("invalid notification filter rule name %q, must be 'prefix' or 'suffix'", rule.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks all the topic, queue and lambda configs for
+// unknown event types and filter rules before they are sent to
+// the server. An empty configuration is valid.
+func (b Configuration) Validate() error {
+	for _, v := range b.TopicConfigs {
+		if err := v.Config.validate(); err != nil {
+			return err
+		}
+	}
+	for _, v := range b.QueueConfigs {
+		if err := v.Config.validate(); err != nil {
+			return err
+		}
+	}
+	for _, v := range b.LambdaConfigs {
+		if err := v.Config.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // AddTopic adds a given topic config to the general bucket notification config
 func (b *Configuration) AddTopic(topicConfig Config) bool {
 	newTopicConfig := TopicConfig{Config: topicConfig, Topic: topicConfig.Arn.String()}
 	for _, n := range b.TopicConfigs {
 		// If new config matches existing one
-		if n.Topic == newTopicConfig.Arn.String() && newTopicConfig.Filter == n.Filter {
+		if n.Topic == newTopicConfig.Arn.String() && equalFilter(newTopicConfig.Filter, n.Filter) {
 
 			existingConfig := set.NewStringSet()
 			for _, v := range n.Events {
@@ -307,7 +403,7 @@ func (b *Configuration) AddTopic(topicConfig Config) bool {
 func (b *Configuration) AddQueue(queueConfig Config) bool {
 	newQueueConfig := QueueConfig{Config: queueConfig, Queue: queueConfig.Arn.String()}
 	for _, n := range b.QueueConfigs {
-		if n.Queue == newQueueConfig.Arn.String() && newQueueConfig.Filter == n.Filter {
+		if n.Queue == newQueueConfig.Arn.String() && equalFilter(newQueueConfig.Filter, n.Filter) {
 
 			existingConfig := set.NewStringSet()
 			for _, v := range n.Events {
@@ -332,7 +428,7 @@ func (b *Configuration) AddQueue(queueConfig Config) bool {
 func (b *Configuration) AddLambda(lambdaConfig Config) bool {
 	newLambdaConfig := LambdaConfig{Config: lambdaConfig, Lambda: lambdaConfig.Arn.String()}
 	for _, n := range b.LambdaConfigs {
-		if n.Lambda == newLambdaConfig.Arn.String() && newLambdaConfig.Filter == n.Filter {
+		if n.Lambda == newLambdaConfig.Arn.String() && equalFilter(newLambdaConfig.Filter, n.Filter) {
 
 			existingConfig := set.NewStringSet()
 			for _, v := range n.Events {
@@ -1433,3 +1433,116 @@ func TestNewArnFromString(t *testing.T) {
 		}
 	})
 }
+
+func TestEventTypeIsValid(t *testing.T) {
+	testCases := []struct {
+		event EventType
+		valid bool
+	}{
+		{ObjectCreatedAll, true},
+		{ObjectCreatedPut, true},
+		{ObjectRemovedDeleteMarkerCreated, true},
+		{ObjectReducedRedundancyLostObject, true},
+		{ILMDelMarkerExpirationDelete, true},
+		{BucketCreatedAll, true},
+		{"s3:ObjectTagging:*", true},
+		{"s3:LifecycleTransition", true},
+		{"", false},
+		{"s3:", false},
+		{"s3:ObjectCreated*", false},
+		{"s3:ObjectCreatd:*", false},
+		{"s3:ObjectCreated:", false},
+		{"s3:ObjectCreated:Put:Copy", false},
+		{"s3:ObjectCreated:Put*", false},
+		{"ObjectCreated:Put", false},
+	}
+	for _, testCase := range testCases {
+		if valid := testCase.event.IsValid(); valid != testCase.valid {
+			t.Errorf("%q: expected valid %v, got %v", testCase.event, testCase.valid, valid)
+		}
+	}
+}
+
+func TestConfigurationValidate(t *testing.T) {
+	arn := NewArn("minio", "sqs", "us-east-1", "1", "webhook")
+
+	valid := NewConfig(arn)
+	valid.AddEvents(ObjectCreatedAll, ObjectRemovedAll)
+	valid.AddFilterPrefix("photos/")
+	valid.AddFilterSuffix(".jpg")
+
+	typo := NewConfig(arn)
+	typo.AddEvents("s3:ObjectCreated*")
+
+	noEvents := NewConfig(arn)
+
+	badFilter := NewConfig(arn)
+	badFilter.AddEvents(ObjectCreatedAll)
+	badFilter.Filter.S3Key.FilterRules = append(badFilter.Filter.S3Key.FilterRules, FilterRule{Name: "infix", Value: "x"})
+
+	capitalized := NewConfig(arn)
+	capitalized.AddEvents(ObjectCreatedAll)
+	capitalized.Filter = &Filter{S3Key: S3Key{FilterRules: []FilterRule{{Name: "Prefix", Value: "photos/"}, {Name: "Suffix", Value: ".jpg"}}}}
+
+	testCases := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{"valid", valid, false},
+		{"capitalized filter rules", capitalized, false},
+		{"invalid event", typo, true},
+		{"no events", noEvents, true},
+		{"invalid filter rule", badFilter, true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var cfg Configuration
+			cfg.AddQueue(testCase.config)
+			if err := cfg.Validate(); (err != nil) != testCase.wantErr {
+				t.Errorf("expected error %v, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+
+	if err := (Configuration{}).Validate(); err != nil {
+		t.Errorf("expected empty configuration to be valid, got %v", err)
+	}
+}
+
+func TestConfigurationAddOverlapping(t *testing.T) {
+	arn := NewArn("minio", "sqs", "us-east-1", "1", "webhook")
+	newConfig := func(prefix string, events ...EventType) Config {
+		config := NewConfig(arn)
+		config.AddEvents(events...)
+		if prefix != "" {
+			config.AddFilterPrefix(prefix)
+		}
+		return config
+	}
+
+	var cfg Configuration
+	if !cfg.AddQueue(newConfig("photos/", ObjectCreatedAll)) {
+		t.Fatal("expected the first config to be added")
+	}
+	if cfg.AddQueue(newConfig("photos/", ObjectCreatedAll, ObjectRemovedAll)) {
+		t.Error("expected a config with the same filter and overlapping events to be rejected")
+	}
+	if !cfg.AddQueue(newConfig("photos/", ObjectRemovedAll)) {
+		t.Error("expected a config with the same filter and other events to be added")
+	}
+	if !cfg.AddQueue(newConfig("videos/", ObjectCreatedAll)) {
+		t.Error("expected a config with another filter to be added")
+	}
+
+	if !cfg.AddTopic(newConfig("", ObjectCreatedAll)) {
+		t.Fatal("expected the first topic config to be added")
+	}
+	if cfg.AddTopic(Config{Arn: arn, Events: []EventType{ObjectCreatedAll}}) {
+		t.Error("expected a topic config without filter rules to match an empty filter")
+	}
+	lambda := newConfig("", ObjectCreatedAll)
+	if !cfg.AddLambda(lambda) || cfg.AddLambda(lambda) {
+		t.Error("expected a lambda config to be added only once")
+	}
+}
@@ -465,7 +465,11 @@ func (r Rule) Validate() error {
 		return fmt.Errorf("priority must be set for the rule")
 	}
 
-	if err := r.validateStatus(); err != nil {
+	if r.Destination.Bucket == "" {
+		return fmt.Errorf("destination bucket must be set for the rule")
+	}
+
+	if err := r.SourceSelectionCriteria.Validate(); err != nil {
 		return err
 	}
 	return r.ExistingObjectReplication.Validate()
@@ -527,6 +531,17 @@ func (r Rule) Tags() string {
 	return buf.String()
 }
 
+// MarshalXML - a rule is always sent with a Filter, the form in which
+// AWS requires DeleteMarkerReplication, an unset status is sent as
+// Disabled.
+func (r Rule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.DeleteMarkerReplication.IsEmpty() {
+		r.DeleteMarkerReplication.Status = Disabled
+	}
+	type rule Rule
+	return e.EncodeElement(rule(r), start)
+}
+
 // Filter - a filter for a replication configuration Rule.
 type Filter struct {
 	XMLName xml.Name `xml:"Filter" json:"-"`
@@ -639,6 +654,16 @@ func (d DeleteReplication) IsEmpty() bool {
 	return len(d.Status) == 0
 }
 
+// MarshalXML - omits the element if the status is not set, as
+// servers other than MinIO do not know this extension.
+func (d DeleteReplication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if d.IsEmpty() {
+		return nil
+	}
+	type deleteReplication DeleteReplication
+	return e.EncodeElement(deleteReplication(d), start)
+}
+
 // ReplicaModifications specifies if replica modification sync is enabled
 type ReplicaModifications struct {
 	Status Status `xml:"Status" json:"Status"` // should be set to "Enabled" by default
@@ -654,6 +679,15 @@ func (s SourceSelectionCriteria) IsValid() bool {
 	return s.ReplicaModifications.Status == Enabled || s.ReplicaModifications.Status == Disabled
 }
 
+// MarshalXML - omits the element if no criteria are set.
+func (s SourceSelectionCriteria) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if (s == SourceSelectionCriteria{}) {
+		return nil
+	}
+	type sourceSelectionCriteria SourceSelectionCriteria
+	return e.EncodeElement(sourceSelectionCriteria(s), start)
+}
+
 // Validate source selection criteria
 func (s SourceSelectionCriteria) Validate() error {
 	if (s == SourceSelectionCriteria{}) {
@@ -675,6 +709,15 @@ func (e ExistingObjectReplication) IsEmpty() bool {
 	return len(e.Status) == 0
 }
 
+// MarshalXML - omits the element if the status is not set.
+func (e ExistingObjectReplication) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if e.IsEmpty() {
+		return nil
+	}
+	type existingObjectReplication ExistingObjectReplication
+	return enc.EncodeElement(existingObjectReplication(e), start)
+}
+
 // Validate validates whether the status is disabled.
 func (e ExistingObjectReplication) Validate() error {
 	if e.IsEmpty() {
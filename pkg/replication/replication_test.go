@@ -18,6 +18,8 @@
 package replication
 
 import (
+	"encoding/xml"
+	"strings"
 	"testing"
 )
 
@@ -348,3 +350,65 @@ func TestEditReplicationRule(t *testing.T) {
 		}
 	}
 }
+
+// Tests replication configuration serialization.
+func TestReplicationConfigXML(t *testing.T) {
+	cfg := Config{
+		Role: "arn:aws:iam::123456789012:role/replication",
+		Rules: []Rule{{
+			ID:          "rule-1",
+			Status:      Enabled,
+			Priority:    1,
+			Destination: Destination{Bucket: "arn:aws:s3:::destbucket"},
+			Filter:      Filter{Prefix: "abc/"},
+		}},
+	}
+	if err := cfg.Rules[0].Validate(); err != nil {
+		t.Fatal(err)
+	}
+	cfgBytes, err := xml.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Unset optional elements are omitted.
+	for _, element := range []string{"DeleteReplication", "SourceSelectionCriteria", "ExistingObjectReplication"} {
+		if strings.Contains(string(cfgBytes), element) {
+			t.Errorf("Expected %s to be omitted, got %s", element, cfgBytes)
+		}
+	}
+	// Rules with a Filter require DeleteMarkerReplication, disabled if unset.
+	const deleteMarkersDisabled = "<DeleteMarkerReplication><Status>Disabled</Status></DeleteMarkerReplication>"
+	if !strings.Contains(string(cfgBytes), deleteMarkersDisabled) {
+		t.Errorf("Expected %s, got %s", deleteMarkersDisabled, cfgBytes)
+	}
+	cfg.Rules[0].DeleteMarkerReplication = DeleteMarkerReplication{Status: Disabled}
+	if cfgBytes, err = xml.Marshal(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(cfgBytes), "<Filter><Prefix>abc/</Prefix>") || !strings.Contains(string(cfgBytes), deleteMarkersDisabled) {
+		t.Errorf("Expected the Filter rule with %s, got %s", deleteMarkersDisabled, cfgBytes)
+	}
+
+	cfg.Rules[0].DeleteMarkerReplication = DeleteMarkerReplication{Status: Enabled}
+	cfg.Rules[0].ExistingObjectReplication = ExistingObjectReplication{Status: Disabled}
+	if cfgBytes, err = xml.Marshal(cfg); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Config
+	if err = xml.Unmarshal(cfgBytes, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	rule := decoded.Rules[0]
+	if rule.DeleteMarkerReplication.Status != Enabled || rule.ExistingObjectReplication.Status != Disabled ||
+		rule.Destination.Bucket != "arn:aws:s3:::destbucket" || rule.Prefix() != "abc/" || decoded.Role != cfg.Role {
+		t.Errorf("Unexpected decoded rule %+v", rule)
+	}
+	if !rule.DeleteReplication.IsEmpty() {
+		t.Errorf("Expected DeleteReplication to be unset, got %+v", rule.DeleteReplication)
+	}
+
+	rule.Destination.Bucket = ""
+	if err = rule.Validate(); err == nil {
+		t.Error("Expected a rule without destination bucket to be invalid")
+	}
+}
@@ -18,7 +18,9 @@
 package signer
 
 import (
+	"net/http"
 	"sort"
+	"strings"
 	"testing"
 )
 
@@ -34,3 +36,18 @@ func TestResourceListSorting(t *testing.T) {
 		}
 	}
 }
+
+// Tests that response header overrides are part of the V2 presign string to sign.
+func TestPreStringToSignV2ResponseOverrides(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://s3.amazonaws.com/bucket/object?"+
+		"response-content-type=text%2Fplain&response-content-disposition=attachment%3B%20filename%3D%22a%20b.txt%22", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Expires", "1700000000")
+
+	expected := `/bucket/object?response-content-disposition=attachment; filename="a b.txt"&response-content-type=text/plain`
+	if stringToSign := preStringToSignV2(*req, false); !strings.HasSuffix(stringToSign, expected) {
+		t.Errorf("Expected string to sign to end with %q, got %q", expected, stringToSign)
+	}
+}
@@ -30,6 +30,9 @@ type ApplySSEByDefault struct {
 // Rule layer encapsulates default encryption configuration
 type Rule struct {
 	Apply ApplySSEByDefault `xml:"ApplyServerSideEncryptionByDefault"`
+	// BucketKeyEnabled uses an S3 Bucket Key for SSE-KMS,
+	// reducing the requests made to the KMS.
+	BucketKeyEnabled bool `xml:"BucketKeyEnabled,omitempty"`
 }
 
 // Configuration is the default encryption configuration structure
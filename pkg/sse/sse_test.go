@@ -0,0 +1,60 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sse
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestConfigurationXML(t *testing.T) {
+	testCases := []struct {
+		config   *Configuration
+		expected string
+	}{
+		{
+			NewConfigurationSSES3(),
+			`<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault></Rule></ServerSideEncryptionConfiguration>`,
+		},
+		{
+			NewConfigurationSSEKMS("my-key"),
+			`<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault><KMSMasterKeyID>my-key</KMSMasterKeyID><SSEAlgorithm>aws:kms</SSEAlgorithm></ApplyServerSideEncryptionByDefault></Rule></ServerSideEncryptionConfiguration>`,
+		},
+		{
+			&Configuration{Rules: []Rule{{Apply: ApplySSEByDefault{KmsMasterKeyID: "my-key", SSEAlgorithm: "aws:kms"}, BucketKeyEnabled: true}}},
+			`<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault><KMSMasterKeyID>my-key</KMSMasterKeyID><SSEAlgorithm>aws:kms</SSEAlgorithm></ApplyServerSideEncryptionByDefault><BucketKeyEnabled>true</BucketKeyEnabled></Rule></ServerSideEncryptionConfiguration>`,
+		},
+	}
+	for i, testCase := range testCases {
+		buf, err := xml.Marshal(testCase.config)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if string(buf) != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, buf)
+		}
+
+		var config Configuration
+		if err = xml.Unmarshal(buf, &config); err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if len(config.Rules) != 1 || config.Rules[0] != testCase.config.Rules[0] {
+			t.Errorf("Test %d: expected %+v, got %+v", i+1, testCase.config.Rules, config.Rules)
+		}
+	}
+}
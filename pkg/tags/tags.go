@@ -270,26 +270,42 @@ type tagging struct {
 type Tags tagging
 
 func (tags Tags) String() string {
+	if tags.TagSet == nil {
+		return ""
+	}
 	return tags.TagSet.String()
 }
 
 // Remove removes a tag by its key.
 func (tags *Tags) Remove(key string) {
+	if tags.TagSet == nil {
+		return
+	}
 	tags.TagSet.remove(key)
 }
 
-// Set sets new tag.
+// Set sets new tag. Tags of a zero value are validated
+// as bucket tags.
 func (tags *Tags) Set(key, value string) error {
+	if tags.TagSet == nil {
+		tags.TagSet = &tagSet{tagMap: make(map[string]string)}
+	}
 	return tags.TagSet.set(key, value, false)
 }
 
 // Count - return number of tags accounted for
 func (tags Tags) Count() int {
+	if tags.TagSet == nil {
+		return 0
+	}
 	return tags.TagSet.count()
 }
 
 // ToMap returns copy of tags.
 func (tags Tags) ToMap() map[string]string {
+	if tags.TagSet == nil {
+		return map[string]string{}
+	}
 	return tags.TagSet.toMap()
 }
 
@@ -18,6 +18,7 @@
 package tags
 
 import (
+	"encoding/xml"
 	"fmt"
 	"testing"
 )
@@ -110,3 +111,30 @@ func BenchmarkParseTags(b *testing.B) {
 		ParseObjectTags("key1=value1&key2=value2")
 	}
 }
+
+func TestZeroTags(t *testing.T) {
+	var tags Tags
+	if tags.Count() != 0 || tags.String() != "" || len(tags.ToMap()) != 0 {
+		t.Fatalf("expected zero tags to be empty, got %v", tags.ToMap())
+	}
+	tags.Remove("key")
+
+	if err := tags.Set("key", "value"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tags.Set("key", "value!"); err == nil {
+		t.Error("expected an error for an invalid tag value")
+	}
+	if tags.Count() != 1 || tags.String() != "key=value" {
+		t.Errorf("unexpected tags %v", tags.ToMap())
+	}
+
+	buf, err := xml.Marshal(&tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "<Tagging><TagSet><Tag><Key>key</Key><Value>value</Value></Tag></TagSet></Tagging>"
+	if string(buf) != expected {
+		t.Errorf("expected %s, got %s", expected, buf)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/goccy/go-json"
 	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/minio/minio-go/v7/pkg/tags"
 )
@@ -350,7 +351,10 @@ func (p PostPolicy) marshalJSON() []byte {
 	var conditionsStr string
 	conditions := []string{}
 	for _, po := range p.conditions {
-		conditions = append(conditions, fmt.Sprintf("[\"%s\",\"%s\",\"%s\"]", po.matchType, po.condition, po.value))
+		// Values may contain quotes, e.g. the XML declaration of
+		// the tagging, escape them to keep the policy valid JSON.
+		condition, _ := json.Marshal([]string{po.matchType, po.condition, po.value})
+		conditions = append(conditions, string(condition))
 	}
 	if p.contentLengthRange.min != 0 || p.contentLengthRange.max != 0 {
 		conditions = append(conditions, fmt.Sprintf("[\"content-length-range\", %d, %d]",
@@ -0,0 +1,79 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestPostPolicyJSON(t *testing.T) {
+	const tagging = `<?xml version="1.0" encoding="UTF-8"?><Tagging><TagSet><Tag><Key>k</Key><Value>v</Value></Tag></TagSet></Tagging>`
+
+	p := NewPostPolicy()
+	if err := p.SetExpires(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetBucket("bucket"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetKey(`uploads/"quoted"\name`); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetTagging(tagging); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetContentLengthRange(1, 1024); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(p.base64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var policy struct {
+		Expiration string          `json:"expiration"`
+		Conditions [][]interface{} `json:"conditions"`
+	}
+	if err = json.Unmarshal(data, &policy); err != nil {
+		t.Fatalf("policy is not valid JSON: %v\n%s", err, data)
+	}
+	if policy.Expiration != "2030-01-01T00:00:00.000Z" {
+		t.Errorf("unexpected expiration %q", policy.Expiration)
+	}
+
+	expected := [][]interface{}{
+		{"eq", "$bucket", "bucket"},
+		{"eq", "$key", `uploads/"quoted"\name`},
+		{"eq", "$tagging", tagging},
+		{"content-length-range", float64(1), float64(1024)},
+	}
+	if len(policy.Conditions) != len(expected) {
+		t.Fatalf("expected %d conditions, got %d", len(expected), len(policy.Conditions))
+	}
+	for i, condition := range policy.Conditions {
+		for j, v := range condition {
+			if v != expected[i][j] {
+				t.Errorf("condition %d: expected %v, got %v", i, expected[i], condition)
+				break
+			}
+		}
+	}
+}
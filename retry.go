@@ -23,6 +23,7 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -43,6 +44,10 @@ var DefaultRetryUnit = 200 * time.Millisecond
 // this maximum time duration.
 var DefaultRetryCap = time.Second
 
+// MaxRetryAfter is the longest wait for a server supplied Retry-After
+// before retrying a request.
+var MaxRetryAfter = time.Minute
+
 // newRetryTimer creates a timer with exponentially increasing
 // delays until the maximum retry attempts are reached.
 func (c *Client) newRetryTimer(ctx context.Context, maxRetry int, unit, cap time.Duration, jitter float64) <-chan int {
@@ -128,6 +133,34 @@ func isHTTPStatusRetryable(httpStatusCode int) (ok bool) {
 	return ok
 }
 
+// retryAfter - returns the delay asked for by the Retry-After header
+// of a response, zero if the header is absent or invalid. The delay
+// never exceeds maxDelay.
+func retryAfter(res *http.Response, maxDelay time.Duration) time.Duration {
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		if seconds > int64(maxDelay/time.Second) {
+			return maxDelay
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			if delay > maxDelay {
+				return maxDelay
+			}
+			return delay
+		}
+	}
+	return 0
+}
+
 // For now, all http Do() requests are retriable except some well defined errors
 func isRequestErrorRetryable(err error) bool {
 	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
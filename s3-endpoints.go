@@ -17,11 +17,19 @@
 
 package minio
 
+import (
+	"regexp"
+	"strings"
+)
+
 type awsS3Endpoint struct {
 	endpoint          string
 	dualstackEndpoint string
 }
 
+// awsRegionRegex - matches the names of AWS regions, such as us-east-1.
+var awsRegionRegex = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+
 // awsS3EndpointMap Amazon S3 endpoint map.
 var awsS3EndpointMap = map[string]awsS3Endpoint{
 	"us-east-1": {
@@ -157,6 +165,19 @@ var awsS3EndpointMap = map[string]awsS3Endpoint{
 // getS3Endpoint get Amazon S3 endpoint based on the bucket location.
 func getS3Endpoint(bucketLocation string, useDualstack bool) (endpoint string) {
 	s3Endpoint, ok := awsS3EndpointMap[bucketLocation]
+	if !ok && awsRegionRegex.MatchString(bucketLocation) {
+		// Regions missing from the map, such as newly launched
+		// regions, follow the same naming scheme.
+		domain := ".amazonaws.com"
+		if strings.HasPrefix(bucketLocation, "cn-") {
+			domain = ".amazonaws.com.cn"
+		}
+		s3Endpoint = awsS3Endpoint{
+			"s3." + bucketLocation + domain,
+			"s3.dualstack." + bucketLocation + domain,
+		}
+		ok = true
+	}
 	if !ok {
 		// Default to 's3.us-east-1.amazonaws.com' endpoint.
 		if useDualstack {
@@ -0,0 +1,75 @@
+/*
+ * MinIO Go Library for Amazon S3 Compatible Cloud Storage
+ * Copyright 2024 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package minio
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestGetS3Endpoint(t *testing.T) {
+	testCases := []struct {
+		location  string
+		dualstack bool
+		expected  string
+	}{
+		{"eu-west-1", false, "s3.eu-west-1.amazonaws.com"},
+		{"eu-west-1", true, "s3.dualstack.eu-west-1.amazonaws.com"},
+		{"mx-central-1", false, "s3.mx-central-1.amazonaws.com"},
+		{"us-gov-east-9", true, "s3.dualstack.us-gov-east-9.amazonaws.com"},
+		{"cn-south-9", false, "s3.cn-south-9.amazonaws.com.cn"},
+		{"", false, "s3.us-east-1.amazonaws.com"},
+		{"../evil", true, "s3.dualstack.us-east-1.amazonaws.com"},
+	}
+	for i, testCase := range testCases {
+		if endpoint := getS3Endpoint(testCase.location, testCase.dualstack); endpoint != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, endpoint)
+		}
+	}
+}
+
+func TestS3TransferAccelerate(t *testing.T) {
+	c, err := New("s3.amazonaws.com", &Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Secure: true,
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetS3TransferAccelerate("s3-accelerate.amazonaws.com")
+
+	for _, dualstack := range []bool{false, true} {
+		c.SetS3EnableDualstack(dualstack)
+		expected := "bucket.s3-accelerate.amazonaws.com"
+		if dualstack {
+			expected = "bucket.s3-accelerate.dualstack.amazonaws.com"
+		}
+		u, err := c.PresignedGetObject(context.Background(), "bucket", "object", time.Hour, url.Values{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Host != expected {
+			t.Errorf("dualstack %v: expected host %s, got %s", dualstack, expected, u.Host)
+		}
+	}
+}
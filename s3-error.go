@@ -39,6 +39,7 @@ var s3ErrorResponseMap = map[string]string{
 	"NoSuchKey":                         "The specified key does not exist.",
 	"NoSuchUpload":                      "The specified multipart upload does not exist. The upload ID may be invalid, or the upload may have been aborted or completed.",
 	"NotImplemented":                    "A header you provided implies functionality that is not implemented",
+	"NotModified":                       "The object was not modified since the specified ETag or time.",
 	"PreconditionFailed":                "At least one of the pre-conditions you specified did not hold",
 	"RequestTimeTooSkewed":              "The difference between the request time and the server's time is too large.",
 	"SignatureDoesNotMatch":             "The request signature we calculated does not match the signature you provided. Check your key and signing method.",
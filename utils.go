@@ -363,6 +363,7 @@ func ToObjectInfo(bucketName, objectName string, h http.Header) (ObjectInfo, err
 		LastModified:      mtime,
 		ContentType:       contentType,
 		Expires:           expiry,
+		StorageClass:      h.Get(amzStorageClass),
 		VersionID:         h.Get(amzVersionID),
 		IsDeleteMarker:    deleteMarker,
 		ReplicationStatus: h.Get(amzReplicationStatus),
@@ -421,10 +422,10 @@ var readFull = func(r io.Reader, buf []byte) (n int, err error) {
 }
 
 // regCred matches credential string in HTTP header
-var regCred = regexp.MustCompile("Credential=([A-Z0-9]+)/")
+var regCred = regexp.MustCompile("Credential=([^/]+)/")
 
 // regCred matches signature string in HTTP header
-var regSign = regexp.MustCompile("Signature=([[0-9a-f]+)")
+var regSign = regexp.MustCompile("Signature=([0-9a-f]+)")
 
 // Redact out signature value from authorization string.
 func redactSignature(origAuth string) string {
@@ -698,3 +699,34 @@ func (h *hashReaderWrapper) Read(p []byte) (n int, err error) {
 	}
 	return n, err
 }
+
+// parseContentRange - parses a Content-Range response header of the form
+// "bytes <start>-<end>/<size>", size is -1 if the server replied with '*'.
+func parseContentRange(contentRange string) (start, end, size int64, err error) {
+	errMalformed := fmt.Errorf("malformed Content-Range header %q", contentRange)
+	spec, ok := strings.CutPrefix(contentRange, "bytes ")
+	if !ok {
+		return 0, 0, 0, errMalformed
+	}
+	byteRange, sizeStr, ok := strings.Cut(spec, "/")
+	if !ok {
+		return 0, 0, 0, errMalformed
+	}
+	startStr, endStr, ok := strings.Cut(byteRange, "-")
+	if !ok {
+		return 0, 0, 0, errMalformed
+	}
+	if start, err = strconv.ParseInt(startStr, 10, 64); err != nil || start < 0 {
+		return 0, 0, 0, errMalformed
+	}
+	if end, err = strconv.ParseInt(endStr, 10, 64); err != nil || end < start {
+		return 0, 0, 0, errMalformed
+	}
+	size = -1
+	if sizeStr != "*" {
+		if size, err = strconv.ParseInt(sizeStr, 10, 64); err != nil || size <= end {
+			return 0, 0, 0, errMalformed
+		}
+	}
+	return start, end, size, nil
+}
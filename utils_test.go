@@ -20,6 +20,7 @@ package minio
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"testing"
 	"time"
@@ -82,6 +83,10 @@ func TestRedactSignature(t *testing.T) {
 			authValue:                 "AWS4-HMAC-SHA256 Credential=12312313/20170613/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=02131231312313213",
 			expectedRedactedAuthValue: "AWS4-HMAC-SHA256 Credential=**REDACTED**/20170613/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=**REDACTED**",
 		},
+		{
+			authValue:                 "AWS4-HMAC-SHA256 Credential=minio-admin_1/20170613/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=02131231312313213",
+			expectedRedactedAuthValue: "AWS4-HMAC-SHA256 Credential=**REDACTED**/20170613/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=**REDACTED**",
+		},
 	}
 
 	for i, testCase := range testCases {
@@ -429,3 +434,67 @@ func TestIsCustomQueryValue(t *testing.T) {
 		}
 	}
 }
+
+// Tests parsing of Content-Range response headers.
+func TestParseContentRange(t *testing.T) {
+	testCases := []struct {
+		contentRange string
+		start, end   int64
+		size         int64
+		shouldPass   bool
+	}{
+		{"bytes 0-99/1000", 0, 99, 1000, true},
+		{"bytes 900-999/1000", 900, 999, 1000, true},
+		{"bytes 10-19/*", 10, 19, -1, true},
+		{"bytes 0-999/1000", 0, 999, 1000, true},
+		{"bytes 0-1000/1000", 0, 0, 0, false},
+		{"bytes 20-10/1000", 0, 0, 0, false},
+		{"bytes */1000", 0, 0, 0, false},
+		{"items 0-99/1000", 0, 0, 0, false},
+		{"bytes 0-99", 0, 0, 0, false},
+		{"", 0, 0, 0, false},
+	}
+
+	for i, testCase := range testCases {
+		start, end, size, err := parseContentRange(testCase.contentRange)
+		if err != nil && testCase.shouldPass {
+			t.Errorf("Test %d: Expected to pass, but failed with: %v", i+1, err)
+		}
+		if err == nil && !testCase.shouldPass {
+			t.Errorf("Test %d: Expected to fail, but passed", i+1)
+		}
+		if err == nil && (start != testCase.start || end != testCase.end || size != testCase.size) {
+			t.Errorf("Test %d: Expected %d-%d/%d, got %d-%d/%d", i+1, testCase.start, testCase.end, testCase.size, start, end, size)
+		}
+	}
+}
+
+func TestToObjectInfo(t *testing.T) {
+	h := http.Header{}
+	h.Set("ETag", `"abc"`)
+	h.Set("Content-Length", "5")
+	h.Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+	h.Set("X-Amz-Meta-Owner", "alice")
+	h.Set(amzVersionID, "version-1")
+	h.Set(amzStorageClass, "GLACIER")
+	h.Set(amzReplicationStatus, "COMPLETED")
+	h.Set(amzExpiration, `expiry-date="Fri, 23 Dec 2016 00:00:00 GMT", rule-id="rule-1"`)
+	h.Set(amzRestore, `ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`)
+
+	objInfo, err := ToObjectInfo("bucket", "object", h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if objInfo.ETag != "abc" || objInfo.Size != 5 || objInfo.UserMetadata["Owner"] != "alice" {
+		t.Errorf("Unexpected object info %+v", objInfo)
+	}
+	if objInfo.VersionID != "version-1" || objInfo.StorageClass != "GLACIER" || objInfo.ReplicationStatus != "COMPLETED" {
+		t.Errorf("Unexpected object info %+v", objInfo)
+	}
+	if !objInfo.Expiration.Equal(time.Date(2016, 12, 23, 0, 0, 0, 0, time.UTC)) || objInfo.ExpirationRuleID != "rule-1" {
+		t.Errorf("Unexpected expiration %v %s", objInfo.Expiration, objInfo.ExpirationRuleID)
+	}
+	if objInfo.Restore == nil || objInfo.Restore.OngoingRestore || !objInfo.Restore.ExpiryTime.Equal(time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected restore info %+v", objInfo.Restore)
+	}
+}